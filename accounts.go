@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// customUserApp is the app that holds the custom user model. It has to be
+// created before the first migrate run, since swapping AUTH_USER_MODEL later
+// requires rebuilding the auth tables by hand.
+const customUserApp = "accounts"
+
+func (m *Model) createAccountsApp(projectPath, settingsPath string) error {
+	if !m.setupCustomUser {
+		return nil
+	}
+
+	m.updateProgress("Creating custom user model...")
+
+	if err := m.startapp(projectPath, customUserApp); err != nil {
+		return err
+	}
+
+	appPath := m.appDir(projectPath, customUserApp)
+	modelsContent := `from django.contrib.auth.models import AbstractUser
+
+
+class User(AbstractUser):
+    """Project user model. Add profile fields here and run makemigrations."""
+
+    def __str__(self):
+        return self.get_full_name() or self.username
+`
+	if err := os.WriteFile(filepath.Join(appPath, "models.py"), []byte(modelsContent), 0644); err != nil {
+		return fmt.Errorf("failed to create models.py for app %s: %v", customUserApp, err)
+	}
+
+	adminContent := `from django.contrib import admin
+from django.contrib.auth.admin import UserAdmin
+
+from .models import User
+
+admin.site.register(User, UserAdmin)
+`
+	if err := os.WriteFile(filepath.Join(appPath, "admin.py"), []byte(adminContent), 0644); err != nil {
+		return fmt.Errorf("failed to create admin.py for app %s: %v", customUserApp, err)
+	}
+
+	settingsContentBytes, err := os.ReadFile(settingsPath)
+	if err != nil {
+		return fmt.Errorf("failed to read settings.py to add app: %v", err)
+	}
+	settingsContent, err := addToListInSettingsPy(string(settingsContentBytes), "INSTALLED_APPS", m.appModule(customUserApp))
+	if err != nil {
+		return fmt.Errorf("failed to add app '%s' to INSTALLED_APPS: %v", customUserApp, err)
+	}
+	if !strings.Contains(settingsContent, "AUTH_USER_MODEL") {
+		settingsContent += fmt.Sprintf("\n# Custom user model\nAUTH_USER_MODEL = '%s.User'\n", customUserApp)
+	}
+	if err := os.WriteFile(settingsPath, []byte(settingsContent), 0644); err != nil {
+		return fmt.Errorf("failed to write updated settings.py after adding app: %v", err)
+	}
+
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Created custom user model '%s.User' and set AUTH_USER_MODEL.", customUserApp))
+	return nil
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// maxListDisplay caps the columns of a generated changelist so wide models
+// stay readable.
+const maxListDisplay = 6
+
+// adminFields returns the model's fields as the admin sees them, including
+// the timestamp fields added by the spec.
+func (s *modelSpec) adminFields() []modelField {
+	fields := s.fields
+	if s.timestamps {
+		fields = append(fields[:len(fields):len(fields)],
+			modelField{name: "created_at", kind: "datetime"},
+			modelField{name: "updated_at", kind: "datetime"},
+		)
+	}
+	return fields
+}
+
+// adminSource renders a ModelAdmin for the model: changelist columns from the
+// fields that display on one line, search over the char fields and filters
+// for dates and booleans.
+func (s *modelSpec) adminSource() pythonSource {
+	var display, search, filters []string
+	for _, field := range s.adminFields() {
+		quoted := "'" + field.name + "'"
+		switch field.kind {
+		case "text", "json", "m2m", "uuid", "image", "file":
+		default:
+			if len(display) < maxListDisplay {
+				display = append(display, quoted)
+			}
+		}
+		switch field.kind {
+		case "char", "slug", "email", "url":
+			search = append(search, quoted)
+		case "date", "datetime", "bool":
+			filters = append(filters, quoted)
+		}
+	}
+	if len(display) == 0 {
+		display = []string{"'__str__'"}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "@admin.register(%[1]s)\nclass %[1]sAdmin(admin.ModelAdmin):\n", s.name)
+	fmt.Fprintf(&b, "    list_display = (%s)\n", pythonTuple(display))
+	if len(search) > 0 {
+		fmt.Fprintf(&b, "    search_fields = (%s)\n", pythonTuple(search))
+	}
+	if len(filters) > 0 {
+		fmt.Fprintf(&b, "    list_filter = (%s)\n", pythonTuple(filters))
+	}
+	return pythonSource{
+		imports: []string{"from django.contrib import admin", "from .models import " + s.name},
+		body:    b.String(),
+	}
+}
+
+// internalTypeKinds maps Field.get_internal_type() values to the field kinds
+// of a model spec. Types not listed are left out of the generated admin.
+var internalTypeKinds = map[string]string{
+	"CharField":                 "char",
+	"SlugField":                 "slug",
+	"EmailField":                "email",
+	"URLField":                  "url",
+	"TextField":                 "text",
+	"IntegerField":              "int",
+	"SmallIntegerField":         "int",
+	"PositiveIntegerField":      "posint",
+	"PositiveSmallIntegerField": "posint",
+	"PositiveBigIntegerField":   "posint",
+	"BigIntegerField":           "bigint",
+	"FloatField":                "float",
+	"DecimalField":              "decimal",
+	"BooleanField":              "bool",
+	"DateField":                 "date",
+	"DateTimeField":             "datetime",
+	"TimeField":                 "time",
+	"UUIDField":                 "uuid",
+	"JSONField":                 "json",
+	"FileField":                 "file",
+	"ImageField":                "image",
+	"ForeignKey":                "fk",
+	"OneToOneField":             "o2o",
+	"ManyToManyField":           "m2m",
+}
+
+type introspectedModel struct {
+	Name       string `json:"name"`
+	Registered bool   `json:"registered"`
+	Fields     []struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"fields"`
+}
+
+// introspectScript prints the models of one app, their concrete fields and
+// whether the admin already has them, as JSON. Setting up Django runs admin
+// autodiscovery, so registrations in every admin.py are seen.
+const introspectScript = `import json, os, sys
+os.environ.setdefault('DJANGO_SETTINGS_MODULE', sys.argv[1])
+import django
+django.setup()
+from django.apps import apps
+from django.contrib import admin
+models = []
+for model in apps.get_app_config(sys.argv[2]).get_models():
+    fields = [
+        {'name': f.name, 'type': f.get_internal_type()}
+        for f in model._meta.get_fields() if not f.auto_created
+    ]
+    models.append({'name': model.__name__, 'registered': admin.site.is_registered(model), 'fields': fields})
+print(json.dumps(models))
+`
+
+// introspectModels loads the app's models through the project's virtual
+// environment.
+func introspectModels(project *existingProject, appName string) ([]introspectedModel, error) {
+	cmd := exec.Command(getPythonPath(project.root), "-c", introspectScript, project.settingsModule, appName)
+	cmd.Dir = project.root
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("failed to load the models of %s: %v\nOutput: %s", appName, err, string(exitErr.Stderr))
+		}
+		return nil, fmt.Errorf("failed to load the models of %s: %v", appName, err)
+	}
+	var models []introspectedModel
+	if err := json.Unmarshal(output, &models); err != nil {
+		return nil, fmt.Errorf("failed to read the models of %s: %v\nOutput: %s", appName, err, string(output))
+	}
+	return models, nil
+}
+
+// spec converts an introspected model into a model spec for the admin.
+func (im introspectedModel) spec(appName string) *modelSpec {
+	spec := &modelSpec{app: appName, name: im.Name}
+	for _, field := range im.Fields {
+		if kind, ok := internalTypeKinds[field.Type]; ok {
+			spec.fields = append(spec.fields, modelField{name: field.Name, kind: kind})
+		}
+	}
+	return spec
+}
+
+func runGenerateAdmin(args []string) error {
+	fs := flag.NewFlagSet("generate admin", flag.ContinueOnError)
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("usage: django-forge generate admin <app>")
+	}
+	appName := positional[0]
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %v", err)
+	}
+	project, err := findProject(wd)
+	if err != nil {
+		return err
+	}
+	appPath := project.model().appDir(project.root, appName)
+	if _, err := os.Stat(appPath); err != nil {
+		return fmt.Errorf("app '%s' not found in %s", appName, project.root)
+	}
+
+	models, err := introspectModels(project, appName)
+	if err != nil {
+		return err
+	}
+
+	var messages []string
+	adminPath := filepath.Join(appPath, "admin.py")
+	for _, model := range models {
+		if model.Registered {
+			messages = append(messages, fmt.Sprintf("%s is already registered.", model.Name))
+			continue
+		}
+		if err := writePythonSource(adminPath, model.spec(appName).adminSource()); err != nil {
+			return err
+		}
+		messages = append(messages, fmt.Sprintf("✅ Registered %s in %s.", model.Name, filepath.Join(project.model().appDir("", appName), "admin.py")))
+	}
+	if len(models) == 0 {
+		messages = append(messages, fmt.Sprintf("No models found in %s.", appName))
+	}
+
+	printStepMessages(messages)
+	return nil
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+// API authentication schemes.
+const (
+	apiAuthSession = "session"
+	apiAuthToken   = "token"
+	apiAuthJWT     = "jwt"
+)
+
+var apiAuthSchemes = []string{apiAuthSession, apiAuthToken, apiAuthJWT}
+
+// sessionAuthURL is where the project's urls.py mounts REST Framework's
+// login and logout views, outside the versioned API.
+const sessionAuthURL = "/api-auth/"
+
+// apiPermissions are the default permission classes that can be chosen.
+var apiPermissions = []string{"IsAuthenticatedOrReadOnly", "IsAuthenticated", "AllowAny"}
+
+func apiAuthOptions() []huh.Option[string] {
+	return []huh.Option[string]{
+		huh.NewOption("Session (Django login, for browser clients on the same site)", apiAuthSession),
+		huh.NewOption("Token (DRF authtoken, one token per user)", apiAuthToken),
+		huh.NewOption("JWT (SimpleJWT access and refresh tokens)", apiAuthJWT),
+	}
+}
+
+func apiPermissionOptions() []huh.Option[string] {
+	return []huh.Option[string]{
+		huh.NewOption("Read for everyone, write when authenticated", "IsAuthenticatedOrReadOnly"),
+		huh.NewOption("Authenticated users only", "IsAuthenticated"),
+		huh.NewOption("Allow any (no restrictions, not for production)", "AllowAny"),
+	}
+}
+
+// wantsAPI reports whether the answers given so far in the form
+// lead to a project with an API.
+func (m *Model) wantsAPI() bool {
+	for _, option := range []string{"REST API", "API Only", "OpenAPI Docs", "API Extras"} {
+		if contains(m.selectedOptions, option) {
+			return true
+		}
+	}
+	for _, entry := range strings.Split(m.appNamesInput, ",") {
+		if spec, err := parseAppSpec(entry); err == nil && spec.name != "" {
+			if spec.blueprint == blueprintAPI || (spec.blueprint == "" && m.defaultBlueprint == blueprintAPI) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// apiAuthenticationClasses lists DEFAULT_AUTHENTICATION_CLASSES for the
+// chosen scheme. Session authentication is kept next to tokens while the
+// browsable API is served, so its login form keeps working.
+func (m *Model) apiAuthenticationClasses() []string {
+	var classes []string
+	switch m.apiAuth {
+	case apiAuthToken:
+		classes = append(classes, "rest_framework.authentication.TokenAuthentication")
+	case apiAuthJWT:
+		classes = append(classes, "rest_framework_simplejwt.authentication.JWTAuthentication")
+	}
+	if len(classes) == 0 || !m.apiOnly {
+		classes = append(classes, "rest_framework.authentication.SessionAuthentication")
+	}
+	return classes
+}
+
+// apiAuthRoutes returns the imports and URL patterns api.py needs for the
+// chosen scheme's token endpoints. Session login is mounted once by the
+// project's urls.py instead, under sessionAuthURL.
+func (m *Model) apiAuthRoutes() (imports, patterns []string) {
+	switch m.apiAuth {
+	case apiAuthToken:
+		imports = append(imports, "from rest_framework.authtoken.views import obtain_auth_token")
+		patterns = append(patterns, "path('auth/token/', obtain_auth_token, name='api_token_auth')")
+	case apiAuthJWT:
+		imports = append(imports, "from rest_framework_simplejwt.views import TokenObtainPairView, TokenRefreshView")
+		patterns = append(patterns,
+			"path('auth/token/', TokenObtainPairView.as_view(), name='token_obtain_pair')",
+			"path('auth/token/refresh/', TokenRefreshView.as_view(), name='token_refresh')",
+		)
+	}
+	return imports, patterns
+}
+
+// configureAPIAuth installs and configures what the chosen scheme needs
+// beyond REST Framework itself.
+func (m *Model) configureAPIAuth(projectPath, settingsContent string) (string, error) {
+	switch m.apiAuth {
+	case apiAuthToken:
+		updated, err := addToListInSettingsPy(settingsContent, "INSTALLED_APPS", "rest_framework.authtoken")
+		if err != nil {
+			return settingsContent, fmt.Errorf("failed to add rest_framework.authtoken to INSTALLED_APPS: %v", err)
+		}
+		m.stepMessages = append(m.stepMessages, "✅ Configured token authentication; get a token from "+m.apiRoot()+"auth/token/.")
+		return updated, nil
+	case apiAuthJWT:
+		cmd := exec.Command(getPythonPath(projectPath), "-m", "pip", "install", "djangorestframework-simplejwt")
+		cmd.Dir = projectPath
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return settingsContent, fmt.Errorf("failed to install djangorestframework-simplejwt: %v\nOutput: %s", err, string(output))
+		}
+		if !strings.Contains(settingsContent, "SIMPLE_JWT") {
+			settingsContent += `
+# JSON Web Tokens (SimpleJWT)
+from datetime import timedelta
+
+SIMPLE_JWT = {
+    'ACCESS_TOKEN_LIFETIME': timedelta(minutes=30),
+    'REFRESH_TOKEN_LIFETIME': timedelta(days=1),
+}
+`
+		}
+		m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ SimpleJWT installed; get tokens from %[1]sauth/token/ and refresh them at %[1]sauth/token/refresh/.", m.apiRoot()))
+	default:
+		m.stepMessages = append(m.stepMessages, "✅ Configured session authentication; log in at "+sessionAuthURL+"login/.")
+	}
+	return settingsContent, nil
+}
+
+// apiDocsExample renders a titled curl example of the api-docs quick start.
+func apiDocsExample(title, example string) string {
+	return fmt.Sprintf(`                    <div>
+                        <h3 class="text-lg font-semibold text-white mb-3">%s</h3>
+                        <div class="bg-black/50 border border-gray-700 rounded-lg p-4">
+                            <pre class="text-sm text-gray-300 font-mono overflow-x-auto">
+%s</pre>
+                        </div>
+                    </div>
+`, title, example)
+}
+
+func curlExample(method, url string, options ...string) string {
+	example := fmt.Sprintf(`<span class="text-purple-400">curl</span> <span class="text-blue-400">-X %s</span> http://localhost:8000%s`, method, url)
+	for _, option := range options {
+		flag, value, _ := strings.Cut(option, " ")
+		example += fmt.Sprintf(` \
+  <span class="text-blue-400">%s</span> <span class="text-green-400">%s</span>`, flag, value)
+	}
+	return example
+}
+
+// apiAuthDocs renders the authentication endpoints and quick start examples
+// of the api-docs page for the chosen scheme.
+func (m *Model) apiAuthDocs() (endpoints, quickStart string) {
+	credentials := `-d "username=your_username&password=your_password"`
+	switch m.apiAuth {
+	case apiAuthToken:
+		endpoints = apiDocsEndpoint([]string{"POST"}, m.apiRoot()+"auth/token/", "Exchange a username and password for the user's API token.")
+		quickStart = apiDocsExample("1. Get a Token", curlExample("POST", m.apiRoot()+"auth/token/", credentials)) +
+			"\n" + apiDocsExample("2. Call the API", curlExample("GET", m.apiRoot(), `-H "Authorization: Token your_token"`))
+	case apiAuthJWT:
+		endpoints = apiDocsEndpoint([]string{"POST"}, m.apiRoot()+"auth/token/", "Exchange a username and password for an access and a refresh token.") +
+			"\n" + apiDocsEndpoint([]string{"POST"}, m.apiRoot()+"auth/token/refresh/", "Get a new access token with a refresh token.")
+		quickStart = apiDocsExample("1. Get Tokens", curlExample("POST", m.apiRoot()+"auth/token/", credentials)) +
+			"\n" + apiDocsExample("2. Call the API", curlExample("GET", m.apiRoot(), `-H "Authorization: Bearer your_access_token"`)) +
+			"\n" + apiDocsExample("3. Refresh the Access Token", curlExample("POST", m.apiRoot()+"auth/token/refresh/", `-d "refresh=your_refresh_token"`))
+	default:
+		endpoints = apiDocsEndpoint([]string{"GET", "POST"}, sessionAuthURL+"login/", "Log in with a username and password to start a session.") +
+			"\n" + apiDocsEndpoint([]string{"POST"}, sessionAuthURL+"logout/", "End the current session.")
+		quickStart = apiDocsExample("1. Log In", curlExample("GET", sessionAuthURL+"login/", "-c cookies.txt")+"\n"+
+			curlExample("POST", sessionAuthURL+"login/", "-b cookies.txt", "-c cookies.txt",
+				`-d "username=your_username&password=your_password&csrfmiddlewaretoken=$(grep csrftoken cookies.txt | cut -f7)"`)) +
+			"\n" + apiDocsExample("2. Call the API", curlExample("GET", m.apiRoot(), "-b cookies.txt"))
+	}
+	return endpoints, quickStart
+}
+
+// validateAPIAuth checks the --api-auth and --api-permission values.
+func validateAPIAuth(scheme, permission string) error {
+	if !contains(apiAuthSchemes, scheme) {
+		return fmt.Errorf("unknown API authentication '%s' (choose session, token or jwt)", scheme)
+	}
+	if !contains(apiPermissions, permission) {
+		return fmt.Errorf("unknown API permission '%s' (choose %s)", permission, strings.Join(apiPermissions, ", "))
+	}
+	return nil
+}
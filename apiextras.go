@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// apiExtrasSettings are the REST_FRAMEWORK entries of the API extras option:
+// filter, search and ordering backends for every viewset, plus throttling.
+const apiExtrasSettings = `
+    'DEFAULT_FILTER_BACKENDS': [
+        'django_filters.rest_framework.DjangoFilterBackend',
+        'rest_framework.filters.SearchFilter',
+        'rest_framework.filters.OrderingFilter',
+    ],
+    'DEFAULT_THROTTLE_CLASSES': [
+        'rest_framework.throttling.AnonRateThrottle',
+        'rest_framework.throttling.UserRateThrottle',
+    ],
+    'DEFAULT_THROTTLE_RATES': {
+        'anon': '100/hour',
+        'user': '1000/hour',
+    },`
+
+// configureAPIExtras installs django-filter for the filter backend in
+// apiExtrasSettings.
+func (m *Model) configureAPIExtras(projectPath, settingsContent string) (string, error) {
+	if !m.apiExtras {
+		return settingsContent, nil
+	}
+	cmd := exec.Command(getPythonPath(projectPath), "-m", "pip", "install", "django-filter")
+	cmd.Dir = projectPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return settingsContent, fmt.Errorf("failed to install django-filter: %v\nOutput: %s", err, string(output))
+	}
+	updated, err := addToListInSettingsPy(settingsContent, "INSTALLED_APPS", "django_filters")
+	if err != nil {
+		return settingsContent, fmt.Errorf("failed to add django_filters to INSTALLED_APPS: %v", err)
+	}
+	m.stepMessages = append(m.stepMessages, "✅ django-filter installed; viewsets support filtering, ?search= and ?ordering=, with throttling for anonymous and authenticated users.")
+	return updated, nil
+}
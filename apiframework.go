@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+// API frameworks.
+const (
+	apiFrameworkDRF   = "drf"
+	apiFrameworkNinja = "ninja"
+)
+
+var apiFrameworks = []string{apiFrameworkDRF, apiFrameworkNinja}
+
+func apiFrameworkOptions() []huh.Option[string] {
+	return []huh.Option[string]{
+		huh.NewOption("Django REST Framework (serializers and viewsets)", apiFrameworkDRF),
+		huh.NewOption("Django Ninja (type-hinted routes, built-in OpenAPI docs)", apiFrameworkNinja),
+	}
+}
+
+// apiFramework generates the project's API with one of the supported
+// libraries. Both serve api.py under /api/v1/ with one set of routes per
+// model of the api apps.
+type apiFramework interface {
+	// setup installs the library, configures settings.py and writes the
+	// project's api.py with the routes of every api app.
+	setup(projectPath string) error
+	// projectURLs returns the imports and patterns that mount api.py in the
+	// project's urls.py.
+	projectURLs() (localImports, patterns []string)
+	// writeModelAPI adds the model's API code to its app.
+	writeModelAPI(spec *modelSpec, projectPath string) error
+	// modelAPIFiles lists the files writeModelAPI creates in appPath.
+	modelAPIFiles(appPath string) []string
+	// route returns the import and the line of api.py that serve the model
+	// under prefix.
+	route(spec *modelSpec, prefix string) (importLine, registerLine string)
+}
+
+// api returns the implementation of the chosen API framework.
+func (m *Model) api() apiFramework {
+	if m.apiFramework == apiFrameworkNinja {
+		return &ninjaFramework{m: m}
+	}
+	return &restFramework{m: m}
+}
+
+// applyAPIFramework drops the options that only exist for REST Framework
+// when another framework is chosen.
+func (m *Model) applyAPIFramework() {
+	if m.apiFramework == apiFrameworkDRF {
+		return
+	}
+	if m.setupOpenAPI {
+		m.setupOpenAPI = false
+		m.stepMessages = append(m.stepMessages, "💡 Django Ninja serves its own OpenAPI docs at /api/v1/docs; skipping drf-spectacular.")
+	}
+	if m.apiExtras {
+		m.apiExtras = false
+		m.stepMessages = append(m.stepMessages, "⚠️  Warning: API extras need Django REST Framework; skipping them.")
+	}
+}
+
+// hasGeneratedAPIDocs reports whether the API documents itself, in which
+// case the static api-docs page is not created.
+func (m *Model) hasGeneratedAPIDocs() bool {
+	return m.setupOpenAPI || (m.setupAPI && m.apiFramework == apiFrameworkNinja)
+}
+
+// addAPIRoute adds a route to an existing api.py, after the imports and the
+// routes already there. The registering object (the REST Framework router or
+// the NinjaAPI instance) is taken from registerLine.
+func addAPIRoute(apiContent, importLine, registerLine string) (string, error) {
+	if strings.Contains(apiContent, registerLine) {
+		return apiContent, nil
+	}
+	receiver, _, _ := strings.Cut(registerLine, ".")
+
+	lines := strings.Split(addPythonImport(apiContent, importLine), "\n")
+	definition, lastRoute := -1, -1
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, receiver+" = "):
+			definition = i
+		case strings.HasPrefix(line, receiver+"."):
+			lastRoute = i
+		}
+	}
+	if definition == -1 {
+		return apiContent, fmt.Errorf("could not find '%s' in api.py", receiver)
+	}
+	if lastRoute == -1 {
+		lastRoute = definition
+	}
+
+	var updated []string
+	for i, line := range lines {
+		updated = append(updated, line)
+		if i == lastRoute {
+			updated = append(updated, registerLine)
+		}
+	}
+	return strings.Join(updated, "\n"), nil
+}
+
+// validateAPIFramework checks the --api-framework value.
+func validateAPIFramework(framework string) error {
+	if !contains(apiFrameworks, framework) {
+		return fmt.Errorf("unknown API framework '%s' (choose drf or ninja)", framework)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import "testing"
+
+func TestAddAPIRoute(t *testing.T) {
+	restAPI := "from django.urls import path, include\nfrom rest_framework.routers import DefaultRouter\n\nfrom blog.views import PostViewSet\n\nrouter = DefaultRouter()\nrouter.register(r'blog', PostViewSet, basename='post')\n\nurlpatterns = [\n    path('', include(router.urls)),\n]\n"
+	ninjaAPI := "from ninja import NinjaAPI\n\napi = NinjaAPI(title='site API', version='1.0.0')\n\nurlpatterns = []\n"
+	tests := []struct {
+		name         string
+		content      string
+		importLine   string
+		registerLine string
+		want         string
+	}{
+		{
+			name:         "after the last router registration",
+			content:      restAPI,
+			importLine:   "from shop.views import ProductViewSet",
+			registerLine: "router.register(r'shop', ProductViewSet, basename='product')",
+			want:         "from django.urls import path, include\nfrom rest_framework.routers import DefaultRouter\n\nfrom blog.views import PostViewSet\nfrom shop.views import ProductViewSet\n\nrouter = DefaultRouter()\nrouter.register(r'blog', PostViewSet, basename='post')\nrouter.register(r'shop', ProductViewSet, basename='product')\n\nurlpatterns = [\n    path('', include(router.urls)),\n]\n",
+		},
+		{
+			name:         "first router registration",
+			content:      "from rest_framework.routers import DefaultRouter\n\nrouter = DefaultRouter()\n",
+			importLine:   "from shop.views import ProductViewSet",
+			registerLine: "router.register(r'shop', ProductViewSet, basename='product')",
+			want:         "from rest_framework.routers import DefaultRouter\nfrom shop.views import ProductViewSet\n\nrouter = DefaultRouter()\nrouter.register(r'shop', ProductViewSet, basename='product')\n",
+		},
+		{
+			name:         "first Ninja router",
+			content:      ninjaAPI,
+			importLine:   "from shop.api import product_router",
+			registerLine: "api.add_router('/shop/', product_router)",
+			want:         "from ninja import NinjaAPI\nfrom shop.api import product_router\n\napi = NinjaAPI(title='site API', version='1.0.0')\napi.add_router('/shop/', product_router)\n\nurlpatterns = []\n",
+		},
+		{
+			name:         "already registered",
+			content:      restAPI,
+			importLine:   "from blog.views import PostViewSet",
+			registerLine: "router.register(r'blog', PostViewSet, basename='post')",
+			want:         restAPI,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addAPIRoute(tt.content, tt.importLine, tt.registerLine)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+			again, err := addAPIRoute(got, tt.importLine, tt.registerLine)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again != got {
+				t.Errorf("adding the route twice changed api.py:\n%s", again)
+			}
+		})
+	}
+}
+
+func TestAddAPIRouteWithoutRouter(t *testing.T) {
+	if _, err := addAPIRoute("urlpatterns = []\n", "from shop.views import ProductViewSet", "router.register(r'shop', ProductViewSet)"); err == nil {
+		t.Error("expected an error for an api.py without a router")
+	}
+}
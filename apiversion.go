@@ -0,0 +1,250 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+// API versioning strategies of REST Framework.
+const (
+	apiVersioningURL       = "url"
+	apiVersioningNamespace = "namespace"
+	apiVersioningHeader    = "header"
+)
+
+var apiVersioningStrategies = []string{apiVersioningURL, apiVersioningNamespace, apiVersioningHeader}
+
+// apiVersioningClasses maps each strategy to its DEFAULT_VERSIONING_CLASS.
+var apiVersioningClasses = map[string]string{
+	apiVersioningURL:       "rest_framework.versioning.URLPathVersioning",
+	apiVersioningNamespace: "rest_framework.versioning.NamespaceVersioning",
+	apiVersioningHeader:    "rest_framework.versioning.AcceptHeaderVersioning",
+}
+
+// firstAPIVersion is the version new projects serve.
+const firstAPIVersion = "v1"
+
+var (
+	apiVersionPattern      = regexp.MustCompile(`^v[0-9]+$`)
+	allowedVersionsPattern = regexp.MustCompile(`'ALLOWED_VERSIONS': \[([^\]]*)\]`)
+)
+
+func apiVersioningOptions() []huh.Option[string] {
+	return []huh.Option[string]{
+		huh.NewOption("URL path (/api/v1/, /api/v2/)", apiVersioningURL),
+		huh.NewOption("Namespace (/api/v1/ included under the v1 namespace)", apiVersioningNamespace),
+		huh.NewOption("Accept header (/api/ with Accept: application/json; version=v1)", apiVersioningHeader),
+	}
+}
+
+// apiRoot is the URL path the API is served under. Accept header versioning
+// keeps the version out of the URL.
+func (m *Model) apiRoot() string {
+	if m.apiFramework == apiFrameworkDRF && m.apiVersioning == apiVersioningHeader {
+		return "/api/"
+	}
+	return "/api/" + firstAPIVersion + "/"
+}
+
+// pointAPILinks rewrites the /api/v1/ links of a generated page for projects
+// serving the API elsewhere.
+func (m *Model) pointAPILinks(content string) string {
+	return strings.ReplaceAll(content, "/api/"+firstAPIVersion+"/", m.apiRoot())
+}
+
+// apiVersionModule returns the project module that routes version: api for
+// the first version, api_<version> for the ones added later.
+func apiVersionModule(version string) string {
+	if version == firstAPIVersion {
+		return "api"
+	}
+	return "api_" + version
+}
+
+// apiVersionURL returns the pattern that mounts version in the project's
+// urls.py, in the form the versioning class reads the version from.
+func (m *Model) apiVersionURL(version string) string {
+	module := m.packageName + "." + apiVersionModule(version)
+	switch m.apiVersioning {
+	case apiVersioningNamespace:
+		return fmt.Sprintf("path('api/%[1]s/', include(('%[2]s', 'api'), namespace='%[1]s'))", version, module)
+	case apiVersioningHeader:
+		return fmt.Sprintf("path('api/', include('%s'))", module)
+	case apiVersioningURL:
+		return fmt.Sprintf("re_path(r'^api/(?P<version>%s)/', include('%s'))", version, module)
+	default:
+		// Projects created before versioning was configured.
+		return fmt.Sprintf("path('api/v1/', include('%s'))", module)
+	}
+}
+
+// apiVersioningSettings are the REST_FRAMEWORK entries of the chosen
+// strategy.
+func (m *Model) apiVersioningSettings() string {
+	return fmt.Sprintf(`
+    'DEFAULT_VERSIONING_CLASS': '%s',
+    'DEFAULT_VERSION': '%[2]s',
+    'ALLOWED_VERSIONS': ['%[2]s'],`, apiVersioningClasses[m.apiVersioning], firstAPIVersion)
+}
+
+// allowedAPIVersions reads ALLOWED_VERSIONS from the settings, oldest first.
+func allowedAPIVersions(settingsContent string) []string {
+	match := allowedVersionsPattern.FindStringSubmatch(settingsContent)
+	if match == nil {
+		return nil
+	}
+	var versions []string
+	for _, item := range strings.Split(match[1], ",") {
+		if version := strings.Trim(strings.TrimSpace(item), `'"`); version != "" {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// runAdd dispatches "add <kind>", which adds project-level structure to the
+// project in the current directory.
+func runAdd(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: django-forge add api-version <version>")
+	}
+	switch args[0] {
+	case "api-version":
+		return runAddAPIVersion(args[1:])
+	default:
+		return fmt.Errorf("unknown kind '%s' (available: api-version)", args[0])
+	}
+}
+
+// runAddAPIVersion adds a version to the REST Framework API. With URL path
+// and namespace versioning the new version gets its own router module,
+// copied from the latest one, and its own mount in urls.py; with Accept
+// header versioning it only becomes an allowed version.
+func runAddAPIVersion(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: django-forge add api-version <version>")
+	}
+	version := args[0]
+	if !apiVersionPattern.MatchString(version) {
+		return fmt.Errorf("invalid API version '%s' (use v<number>, e.g. v2)", version)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %v", err)
+	}
+	project, err := findProject(wd)
+	if err != nil {
+		return err
+	}
+	m := project.model()
+	if _, err := os.Stat(project.apiPath()); err != nil {
+		return fmt.Errorf("api-version needs a project created with an API (%s not found)", project.apiPath())
+	}
+	if m.apiFramework != apiFrameworkDRF {
+		return fmt.Errorf("api-version needs a REST Framework API; Django Ninja routers can only belong to one API")
+	}
+	if m.apiVersioning == "" {
+		return fmt.Errorf("no DEFAULT_VERSIONING_CLASS found in %s; the project was created without API versioning", project.settingsPath)
+	}
+
+	settingsContent, err := os.ReadFile(project.settingsPath)
+	if err != nil {
+		return fmt.Errorf("failed to read settings: %v", err)
+	}
+	versions := allowedAPIVersions(string(settingsContent))
+	if len(versions) == 0 {
+		return fmt.Errorf("could not find ALLOWED_VERSIONS in %s", project.settingsPath)
+	}
+	if contains(versions, version) {
+		return fmt.Errorf("API version '%s' already exists", version)
+	}
+	latest := versions[len(versions)-1]
+
+	if m.apiVersioning != apiVersioningHeader {
+		if err := m.addAPIVersionModule(project, latest, version); err != nil {
+			return err
+		}
+	}
+
+	quoted := make([]string, 0, len(versions)+1)
+	for _, v := range append(versions, version) {
+		quoted = append(quoted, "'"+v+"'")
+	}
+	updatedSettings := allowedVersionsPattern.ReplaceAllLiteralString(string(settingsContent),
+		"'ALLOWED_VERSIONS': ["+strings.Join(quoted, ", ")+"]")
+	if err := os.WriteFile(project.settingsPath, []byte(updatedSettings), 0644); err != nil {
+		return fmt.Errorf("failed to update settings: %v", err)
+	}
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Added %s to ALLOWED_VERSIONS.", version))
+
+	if m.apiVersioning == apiVersioningHeader {
+		m.stepMessages = append(m.stepMessages, fmt.Sprintf("💡 Clients ask for %[1]s with 'Accept: application/json; version=%[1]s'; branch on request.version in the viewsets.", version))
+	}
+	printStepMessages(m.stepMessages)
+	return nil
+}
+
+// addAPIVersionModule creates the router module of version from the one of
+// previous, so the new version starts with the same endpoints, and mounts it
+// next to previous in urls.py.
+func (m *Model) addAPIVersionModule(project *existingProject, previous, version string) error {
+	packagePath := filepath.Join(project.root, project.packageName)
+	previousPath := filepath.Join(packagePath, apiVersionModule(previous)+".py")
+	versionPath := filepath.Join(packagePath, apiVersionModule(version)+".py")
+	if _, err := os.Stat(versionPath); err == nil {
+		return fmt.Errorf("%s already exists", versionPath)
+	}
+	routes, err := os.ReadFile(previousPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the %s routes: %v", previous, err)
+	}
+
+	urlsContent, err := os.ReadFile(project.urlsPath())
+	if err != nil {
+		return fmt.Errorf("failed to read urls.py: %v", err)
+	}
+	lines := strings.Split(string(urlsContent), "\n")
+	previousURL := m.apiVersionURL(previous)
+	mounted := false
+	for i, line := range lines {
+		if strings.TrimSpace(line) == previousURL+"," {
+			indent := line[:len(line)-len(strings.TrimLeft(line, " "))]
+			lines = append(lines[:i+1], append([]string{indent + m.apiVersionURL(version) + ","}, lines[i+1:]...)...)
+			mounted = true
+			break
+		}
+	}
+	if !mounted {
+		return fmt.Errorf("could not find %s in urls.py to mount %s next to it", previousURL, version)
+	}
+
+	// Replace the note of the version copied from with this version's.
+	body := string(routes)
+	if strings.HasPrefix(body, "# API ") {
+		_, body, _ = strings.Cut(body, "\n")
+	}
+	header := fmt.Sprintf("# API %s, started from %s. Change what differs from %s here.\n", version, previous, previous)
+	if err := os.WriteFile(versionPath, []byte(header+body), 0644); err != nil {
+		return fmt.Errorf("failed to create %s: %v", versionPath, err)
+	}
+	if err := os.WriteFile(project.urlsPath(), []byte(strings.Join(lines, "\n")), 0644); err != nil {
+		return fmt.Errorf("failed to update urls.py: %v", err)
+	}
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Created %s with the routes of %s.", filepath.Join(project.packageName, apiVersionModule(version)+".py"), previous))
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Mounted %s at /api/%s/.", version, version))
+	return nil
+}
+
+// validateAPIVersioning checks the --api-versioning value.
+func validateAPIVersioning(strategy string) error {
+	if !contains(apiVersioningStrategies, strategy) {
+		return fmt.Errorf("unknown API versioning '%s' (choose url, namespace or header)", strategy)
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+// appBlueprint selects what is generated inside a new app beyond startapp.
+type appBlueprint string
+
+const (
+	blueprintEmpty appBlueprint = "empty"
+	blueprintPages appBlueprint = "pages"
+	blueprintCRUD  appBlueprint = "crud"
+	blueprintAPI   appBlueprint = "api"
+)
+
+var blueprintNames = []appBlueprint{blueprintEmpty, blueprintPages, blueprintCRUD, blueprintAPI}
+
+func blueprintOptions() []huh.Option[appBlueprint] {
+	return []huh.Option[appBlueprint]{
+		huh.NewOption("Pages (home, about and contact views with templates)", blueprintPages),
+		huh.NewOption("CRUD (model, ModelForm, list/detail/create/update/delete views)", blueprintCRUD),
+		huh.NewOption("API (model with CRUD endpoints in the project's API)", blueprintAPI),
+		huh.NewOption("Empty (startapp only)", blueprintEmpty),
+	}
+}
+
+func parseBlueprint(name string) (appBlueprint, error) {
+	for _, blueprint := range blueprintNames {
+		if string(blueprint) == name {
+			return blueprint, nil
+		}
+	}
+	return "", fmt.Errorf("unknown blueprint '%s' (choose from empty, pages, crud, api)", name)
+}
+
+// appSpec is one entry of the app list: a name with an optional blueprint,
+// written "blog" or "blog:crud".
+type appSpec struct {
+	name      string
+	blueprint appBlueprint
+}
+
+func parseAppSpec(entry string) (appSpec, error) {
+	name, blueprintName, hasBlueprint := strings.Cut(strings.TrimSpace(entry), ":")
+	spec := appSpec{name: strings.TrimSpace(name)}
+	if hasBlueprint {
+		blueprint, err := parseBlueprint(strings.TrimSpace(blueprintName))
+		if err != nil {
+			return spec, err
+		}
+		spec.blueprint = blueprint
+	}
+	return spec, nil
+}
+
+// blueprintFor returns the blueprint chosen for appName, falling back to the
+// default blueprint for apps listed without one.
+func (m *Model) blueprintFor(appName string) appBlueprint {
+	if blueprint, ok := m.appBlueprints[appName]; ok {
+		return blueprint
+	}
+	if m.defaultBlueprint == "" {
+		return blueprintPages
+	}
+	return m.defaultBlueprint
+}
+
+// appsWithBlueprint returns the apps, in order, that use one of blueprints.
+func (m *Model) appsWithBlueprint(blueprints ...appBlueprint) []string {
+	var apps []string
+	for _, appName := range m.appNames {
+		for _, blueprint := range blueprints {
+			if m.blueprintFor(appName) == blueprint {
+				apps = append(apps, appName)
+				break
+			}
+		}
+	}
+	return apps
+}
+
+// apiOnlyApp is the app created for an API-only project that lists no apps.
+// Its example model is Item rather than one named after the app.
+const apiOnlyApp = "api"
+
+// blueprintModelName derives the example model's class name from the app
+// name: "books" becomes Book and "blog_posts" becomes BlogPost. Only the last
+// word is singularized, and only for plural endings it recognizes; anything
+// else ("news", "status", "data") is kept as it is.
+func blueprintModelName(appName string) string {
+	if appName == apiOnlyApp {
+		return "Item"
+	}
+	words := strings.Split(appName, "_")
+	words[len(words)-1] = singularize(words[len(words)-1])
+	return strings.ReplaceAll(titleWords(strings.Join(words, "_")), " ", "")
+}
+
+// irregularPlurals lists plurals the endings below would get wrong, and
+// uncountable words that have no singular to strip down to.
+var irregularPlurals = map[string]string{
+	"people": "person", "children": "child", "men": "man", "women": "woman",
+	"movies": "movie", "cookies": "cookie", "caches": "cache",
+	"news": "news", "series": "series", "species": "species",
+	"analytics": "analytics", "sms": "sms",
+}
+
+// pluralEndings maps the plural endings singularize recognizes to their
+// singular form, longest first.
+var pluralEndings = []struct{ plural, singular string }{
+	{"ies", "y"},
+	{"sses", "ss"},
+	{"shes", "sh"},
+	{"ches", "ch"},
+	{"xes", "x"},
+	{"s", ""},
+}
+
+// singularize strips a plural ending from word, leaving it unchanged when the
+// ending is ambiguous: words ending in "ss", "us" or "is" (address, status,
+// analysis) are usually already singular.
+func singularize(word string) string {
+	if singular, ok := irregularPlurals[word]; ok {
+		return singular
+	}
+	for _, suffix := range []string{"ss", "us", "is"} {
+		if strings.HasSuffix(word, suffix) {
+			return word
+		}
+	}
+	for _, ending := range pluralEndings {
+		if stem, ok := strings.CutSuffix(word, ending.plural); ok && len(stem) > 1 {
+			return stem + ending.singular
+		}
+	}
+	return word
+}
+
+func (m *Model) setupAppBlueprint(projectPath, appName string) error {
+	switch m.blueprintFor(appName) {
+	case blueprintPages:
+		return m.setupPagesBlueprint(projectPath, appName)
+	case blueprintCRUD:
+		return m.setupCRUDBlueprint(projectPath, appName)
+	case blueprintAPI:
+		return m.setupAPIBlueprint(projectPath, appName)
+	}
+	return nil
+}
+
+// blueprintFiles lists the files a blueprint adds to the startapp output in
+// appPath.
+func (m *Model) blueprintFiles(appPath, appName string, blueprint appBlueprint) []string {
+	var files []string
+	switch blueprint {
+	case blueprintPages:
+		files = append(files, filepath.Join(appPath, "urls.py"))
+		for _, page := range appPages {
+			files = append(files, filepath.Join(appPath, "templates", appName, page.name+".html"))
+		}
+	case blueprintCRUD:
+		files = append(files, filepath.Join(appPath, "urls.py"), filepath.Join(appPath, "forms.py"))
+		modelVar := strings.ToLower(blueprintModelName(appName))
+		for _, suffix := range []string{"list", "detail", "form", "confirm_delete"} {
+			files = append(files, filepath.Join(appPath, "templates", appName, modelVar+"_"+suffix+".html"))
+		}
+		files = append(files, m.sampleDataFiles(appPath, appName)...)
+	case blueprintAPI:
+		files = append(files, m.api().modelAPIFiles(appPath)...)
+		files = append(files, m.sampleDataFiles(appPath, appName)...)
+	}
+	return files
+}
+
+// appPage wraps body in the project's base template, or in a bare HTML page
+// when the project has no global templates.
+func appPage(projectPath, title, body string) string {
+	if _, err := os.Stat(filepath.Join(projectPath, "templates", "base.html")); os.IsNotExist(err) {
+		return fmt.Sprintf(`<!DOCTYPE html>
+<html>
+<head><title>%s</title></head>
+<body>
+%s
+</body>
+</html>
+`, title, body)
+	}
+	return fmt.Sprintf(`{%% extends 'base.html' %%}
+{%% block title %%}%s{%% endblock %%}
+{%% block content %%}
+%s
+{%% endblock %%}
+`, title, body)
+}
+
+// writeAppFile writes content to path inside the app directory appPath.
+func writeAppFile(appPath, content string, path ...string) error {
+	filePath := filepath.Join(append([]string{appPath}, path...)...)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %v", filePath, err)
+	}
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to create %s for app %s: %v", filepath.Join(path...), filepath.Base(appPath), err)
+	}
+	return nil
+}
+
+// appPages are the static pages of the pages blueprint: URL path, view name
+// and title.
+var appPages = []struct {
+	path, name, title string
+}{
+	{"", "index", "Home"},
+	{"about/", "about", "About"},
+	{"contact/", "contact", "Contact"},
+}
+
+func (m *Model) setupPagesBlueprint(projectPath, appName string) error {
+	var nav []string
+	for _, page := range appPages {
+		nav = append(nav, fmt.Sprintf(`<a href="{%% url '%s:%s' %%}">%s</a>`, appName, page.name, page.title))
+	}
+	navHTML := "<nav>\n    " + strings.Join(nav, "\n    ") + "\n</nav>"
+
+	var views, urls []string
+	for _, page := range appPages {
+		title := titleWords(appName) + " " + page.title
+		body := fmt.Sprintf("%s\n<h1>%s</h1>\n<p>Edit %s to change this page.</p>", navHTML, title, filepath.ToSlash(filepath.Join(m.appDir("", appName), "templates", appName, page.name+".html")))
+		if err := writeAppFile(m.appDir(projectPath, appName), appPage(projectPath, title, body), "templates", appName, page.name+".html"); err != nil {
+			return err
+		}
+		views = append(views, fmt.Sprintf(`def %s(request):
+    return render(request, '%s/%s.html')
+`, page.name, appName, page.name))
+		urls = append(urls, fmt.Sprintf("    path('%s', views.%s, name='%s'),", page.path, page.name, page.name))
+	}
+
+	viewsContent := "from django.shortcuts import render\n\n\n" + strings.Join(views, "\n\n")
+	if err := writeAppFile(m.appDir(projectPath, appName), viewsContent, "views.py"); err != nil {
+		return err
+	}
+
+	urlsContent := fmt.Sprintf(`from django.urls import path
+
+from . import views
+
+app_name = '%s'
+urlpatterns = [
+%s
+]
+`, appName, strings.Join(urls, "\n"))
+	if err := writeAppFile(m.appDir(projectPath, appName), urlsContent, "urls.py"); err != nil {
+		return err
+	}
+
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Created home, about and contact pages for app: %s", appName))
+	return nil
+}
+
+// blueprintModel is the example model the CRUD and API blueprints generate,
+// named after the app.
+func (m *Model) blueprintModel(appName string) *modelSpec {
+	fields := []modelField{
+		{name: "title", kind: "char", arg: "200"},
+		{name: "description", kind: "text", optional: true},
+	}
+	if m.setupMedia {
+		fields = append(fields, modelField{name: "image", kind: "image", optional: true})
+	}
+	return &modelSpec{app: appName, module: m.appModule(appName), name: blueprintModelName(appName), fields: fields, timestamps: true, filters: m.apiExtras}
+}
+
+func (m *Model) setupCRUDBlueprint(projectPath, appName string) error {
+	spec := m.blueprintModel(appName)
+	if err := spec.writeModel(projectPath, true); err != nil {
+		return err
+	}
+	if _, err := spec.writeCRUD(projectPath, ""); err != nil {
+		return err
+	}
+	if err := m.writeSampleData(spec, projectPath); err != nil {
+		return err
+	}
+
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Created %s model, form, CRUD views and templates for app: %s", spec.name, appName))
+	return nil
+}
+
+func (m *Model) setupAPIBlueprint(projectPath, appName string) error {
+	spec := m.blueprintModel(appName)
+	if err := spec.writeModel(projectPath, false); err != nil {
+		return err
+	}
+	if err := m.api().writeModelAPI(spec, projectPath); err != nil {
+		return err
+	}
+
+	if err := m.writeSampleData(spec, projectPath); err != nil {
+		return err
+	}
+
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Created %s model and API for app: %s", spec.name, appName))
+	return nil
+}
+
+// blueprintAPIPrefix is the router prefix of an API app's viewset: the app
+// name, or the model's URL segment for the dedicated api app, which would
+// otherwise be served at /api/v1/api/.
+func (m *Model) blueprintAPIPrefix(appName string) string {
+	if appName == apiOnlyApp {
+		return m.blueprintModel(appName).urlSegment()
+	}
+	return appName
+}
@@ -0,0 +1,29 @@
+package main
+
+import "testing"
+
+func TestBlueprintModelName(t *testing.T) {
+	tests := map[string]string{
+		"books":      "Book",
+		"blog_posts": "BlogPost",
+		"categories": "Category",
+		"addresses":  "Address",
+		"boxes":      "Box",
+		"branches":   "Branch",
+		"wishes":     "Wish",
+		"movies":     "Movie",
+		"people":     "Person",
+		"news":       "News",
+		"status":     "Status",
+		"analysis":   "Analysis",
+		"address":    "Address",
+		"blog":       "Blog",
+		"inventory":  "Inventory",
+		"sms":        "Sms",
+	}
+	for appName, want := range tests {
+		if got := blueprintModelName(appName); got != want {
+			t.Errorf("blueprintModelName(%q) = %q, want %q", appName, got, want)
+		}
+	}
+}
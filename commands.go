@@ -0,0 +1,173 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// runCommand dispatches the subcommands that work on an existing project.
+func runCommand(args []string) error {
+	switch args[0] {
+	case "new":
+		return runNew(args[1:])
+	case "add-app":
+		return runAddApp(args[1:])
+	case "add":
+		return runAdd(args[1:])
+	case "generate":
+		return runGenerate(args[1:])
+	default:
+		return fmt.Errorf("unknown command '%s' (see django-forge --help)", args[0])
+	}
+}
+
+// runNew handles "new [dir] [flags]". An existing directory (such as ".")
+// is scaffolded in place; anything else is created as a new project.
+func runNew(arguments []string) error {
+	fs := flag.NewFlagSet("new", flag.ContinueOnError)
+	args := defineFlags(fs)
+	positional, err := parseInterspersed(fs, arguments)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return fmt.Errorf("usage: django-forge new [dir] [flags]")
+	}
+	if len(positional) == 1 {
+		if err := newTarget(args, positional[0]); err != nil {
+			return err
+		}
+	}
+
+	runCreate(*args)
+	return nil
+}
+
+// newTarget points args at the directory given to "new", rejecting a
+// --name or --dir that contradicts it.
+func newTarget(args *CLIArgs, target string) error {
+	if info, err := os.Stat(target); err == nil && info.IsDir() {
+		absTarget, err := filepath.Abs(target)
+		if err != nil {
+			return fmt.Errorf("failed to resolve %s: %v", target, err)
+		}
+		if args.Dir != "" {
+			if absDir, err := filepath.Abs(args.Dir); err != nil || absDir != absTarget {
+				return fmt.Errorf("conflicting directories '%s' and --dir '%s'", target, args.Dir)
+			}
+		}
+		args.InPlace = true
+		args.Dir = absTarget
+		if args.ProjectName == "" {
+			args.ProjectName = filepath.Base(absTarget)
+		}
+		return nil
+	}
+
+	name := filepath.Base(target)
+	if args.ProjectName != "" && args.ProjectName != name {
+		return fmt.Errorf("conflicting project names '%s' and --name '%s'", target, args.ProjectName)
+	}
+	args.ProjectName = name
+	if parent := filepath.Dir(target); parent != "." {
+		if args.Dir != "" {
+			return fmt.Errorf("conflicting directories '%s' and --dir '%s'", target, args.Dir)
+		}
+		args.Dir = parent
+	}
+	return nil
+}
+
+func runAddApp(args []string) error {
+	fs := flag.NewFlagSet("add-app", flag.ContinueOnError)
+	blueprintName := fs.String("blueprint", string(blueprintPages), "What to generate: empty, pages, crud or api")
+	noTemplates := fs.Bool("no-templates", false, "Only create and register the app (same as --blueprint empty)")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("usage: django-forge add-app <name>[:blueprint] [--blueprint name] [--no-templates]")
+	}
+	spec, err := parseAppSpec(positional[0])
+	if err != nil {
+		return err
+	}
+	appName := spec.name
+	if spec.blueprint == "" {
+		if spec.blueprint, err = parseBlueprint(*blueprintName); err != nil {
+			return err
+		}
+		if *noTemplates {
+			spec.blueprint = blueprintEmpty
+		}
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %v", err)
+	}
+	project, err := findProject(wd)
+	if err != nil {
+		return err
+	}
+	if err := validateAppName(appName, project.packageName); err != nil {
+		return err
+	}
+
+	m := project.model()
+	if _, err := os.Stat(m.appDir(project.root, appName)); err == nil {
+		return fmt.Errorf("'%s' already exists in %s", m.appDir("", appName), project.root)
+	}
+	settingsContent, err := os.ReadFile(project.settingsPath)
+	if err != nil {
+		return fmt.Errorf("failed to read settings: %v", err)
+	}
+	if strings.Contains(string(settingsContent), fmt.Sprintf("'%s'", m.appModule(appName))) {
+		return fmt.Errorf("'%s' is already listed in INSTALLED_APPS", appName)
+	}
+	if _, err := os.Stat(project.apiPath()); spec.blueprint == blueprintAPI && err != nil {
+		return fmt.Errorf("the api blueprint needs a project created with an API (%s not found)", project.apiPath())
+	}
+
+	m.appNames = []string{appName}
+	m.appBlueprints = map[string]appBlueprint{appName: spec.blueprint}
+	if err := m.createDjangoApp(project.root, project.settingsPath, appName); err != nil {
+		return err
+	}
+	if m.setupMedia && (spec.blueprint == blueprintCRUD || spec.blueprint == blueprintAPI) {
+		if err := m.installPillow(project.root); err != nil {
+			return err
+		}
+	}
+
+	if spec.blueprint == blueprintAPI {
+		importLine, registerLine := m.api().route(m.blueprintModel(appName), m.blueprintAPIPrefix(appName))
+		if err := project.addAPIRoute(importLine, registerLine); err != nil {
+			return err
+		}
+		m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Added the %s API at %s%s/.", blueprintModelName(appName), m.apiRoot(), m.blueprintAPIPrefix(appName)))
+	}
+
+	if spec.blueprint == blueprintPages || spec.blueprint == blueprintCRUD {
+		if err := mountApp(project, appName); err != nil {
+			return err
+		}
+		m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Mounted %s.urls at /%s/.", m.appModule(appName), appName))
+	}
+
+	printStepMessages(m.stepMessages)
+	if spec.blueprint == blueprintCRUD || spec.blueprint == blueprintAPI {
+		fmt.Printf("Next: python manage.py makemigrations %[1]s && python manage.py migrate && python manage.py loaddata %[1]s\n", appName)
+	}
+	return nil
+}
+
+func printStepMessages(messages []string) {
+	for _, msg := range messages {
+		fmt.Println(msg)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestNewTarget(t *testing.T) {
+	existing := t.TempDir()
+	tests := []struct {
+		name     string
+		args     CLIArgs
+		target   string
+		wantName string
+		wantDir  string
+		inPlace  bool
+		wantErr  bool
+	}{
+		{name: "new directory", target: "blog", wantName: "blog"},
+		{name: "nested new directory", target: filepath.Join("sites", "blog"), wantName: "blog", wantDir: "sites"},
+		{name: "matching name", args: CLIArgs{ProjectName: "blog"}, target: "blog", wantName: "blog"},
+		{name: "conflicting name", args: CLIArgs{ProjectName: "bar"}, target: "foo", wantErr: true},
+		{name: "dir for a plain name", args: CLIArgs{Dir: "sites"}, target: "blog", wantName: "blog", wantDir: "sites"},
+		{name: "conflicting dir", args: CLIArgs{Dir: "other"}, target: filepath.Join("sites", "blog"), wantErr: true},
+		{name: "existing directory", target: existing, wantName: filepath.Base(existing), wantDir: existing, inPlace: true},
+		{name: "existing directory with a name", args: CLIArgs{ProjectName: "blog"}, target: existing, wantName: "blog", wantDir: existing, inPlace: true},
+		{name: "existing directory with the same dir", args: CLIArgs{Dir: existing}, target: existing, wantName: filepath.Base(existing), wantDir: existing, inPlace: true},
+		{name: "existing directory with another dir", args: CLIArgs{Dir: t.TempDir()}, target: existing, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := tt.args
+			err := newTarget(&args, tt.target)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got name %q dir %q", args.ProjectName, args.Dir)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if args.ProjectName != tt.wantName || args.Dir != tt.wantDir || args.InPlace != tt.inPlace {
+				t.Errorf("got name %q dir %q in place %v, want %q %q %v", args.ProjectName, args.Dir, args.InPlace, tt.wantName, tt.wantDir, tt.inPlace)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// corsDevOrigins are the allowed origins when CORS_ALLOWED_ORIGINS is not
+// set: the dev servers of common frontend tooling (Create React App, Next.js
+// and Vite).
+var corsDevOrigins = []string{
+	"http://localhost:3000",
+	"http://127.0.0.1:3000",
+	"http://localhost:5173",
+	"http://127.0.0.1:5173",
+}
+
+func (m *Model) configureCORS(projectPath, settingsPath string) error {
+	if !m.setupCORS {
+		return nil
+	}
+	m.updateProgress("Configuring CORS...")
+
+	cmd := exec.Command(getPythonPath(projectPath), "-m", "pip", "install", "django-cors-headers")
+	cmd.Dir = projectPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to install django-cors-headers: %v\nOutput: %s", err, string(output))
+	}
+	m.stepMessages = append(m.stepMessages, "✅ django-cors-headers installed.")
+
+	settingsContent, err := os.ReadFile(settingsPath)
+	if err != nil {
+		return fmt.Errorf("failed to read settings.py for CORS: %v", err)
+	}
+	settingsStr, err := addToListInSettingsPy(string(settingsContent), "INSTALLED_APPS", "corsheaders")
+	if err != nil {
+		return fmt.Errorf("failed to add corsheaders to INSTALLED_APPS: %v", err)
+	}
+
+	// CorsMiddleware has to run before anything that can answer a request
+	// on its own, which includes the redirects of LocaleMiddleware.
+	before := "django.middleware.common.CommonMiddleware"
+	if strings.Contains(settingsStr, "'django.middleware.locale.LocaleMiddleware'") {
+		before = "django.middleware.locale.LocaleMiddleware"
+	}
+	settingsStr, err = insertIntoListInSettingsPy(settingsStr, "MIDDLEWARE", "corsheaders.middleware.CorsMiddleware", before)
+	if err != nil {
+		return fmt.Errorf("failed to add CorsMiddleware to MIDDLEWARE: %v", err)
+	}
+
+	if !strings.Contains(settingsStr, "CORS_ALLOWED_ORIGINS") {
+		settingsStr += fmt.Sprintf(`
+# Cross-origin requests from separate frontends (django-cors-headers).
+# Set CORS_ALLOWED_ORIGINS to a comma-separated list of origins; the
+# default allows local frontend dev servers.
+import os
+
+CORS_ALLOWED_ORIGINS = [
+    origin for origin in os.environ.get(
+        'CORS_ALLOWED_ORIGINS',
+        '%s',
+    ).split(',') if origin
+]
+`, strings.Join(corsDevOrigins, ","))
+		if m.apiAuth == apiAuthSession {
+			settingsStr += `
+# Session authentication sends the session and CSRF cookies cross-origin.
+CORS_ALLOW_CREDENTIALS = True
+CSRF_TRUSTED_ORIGINS = CORS_ALLOWED_ORIGINS
+`
+		}
+	}
+
+	if err := os.WriteFile(settingsPath, []byte(settingsStr), 0644); err != nil {
+		return fmt.Errorf("failed to write updated settings.py: %v", err)
+	}
+	m.stepMessages = append(m.stepMessages, "✅ Configured CORS; set CORS_ALLOWED_ORIGINS to your frontend's origin.")
+	return nil
+}
@@ -9,11 +9,7 @@ import (
 )
 
 func (m *Model) startDevelopmentEnvironment() {
-	projectPath := m.projectName
-	if !filepath.IsAbs(projectPath) {
-		wd, _ := os.Getwd()
-		projectPath = filepath.Join(wd, m.projectName)
-	}
+	projectPath, _ := m.projectDir()
 
 	// Create VS Code tasks.json to automate terminal setup
 	createVSCodeTasks(projectPath, m.setupTailwind)
@@ -124,9 +120,9 @@ func createVSCodeTasks(projectPath string, setupTailwind bool) {
 func (m *Model) setupServerInstructions(projectPath string) {
 	if m.runServer {
 		pythonVenvPath := getPythonPath(projectPath)
-		m.stepMessages = append(m.stepMessages, "✨ To start the server: cd "+m.projectName+" && "+pythonVenvPath+" manage.py runserver")
+		m.stepMessages = append(m.stepMessages, "✨ To start the server: cd "+projectPath+" && "+pythonVenvPath+" manage.py runserver")
 		if m.setupTailwind {
-			m.stepMessages = append(m.stepMessages, "✨ To watch Tailwind CSS: cd "+m.projectName+" && npm run watch:css")
+			m.stepMessages = append(m.stepMessages, "✨ To watch Tailwind CSS: cd "+projectPath+" && npm run watch:css")
 		}
 	}
 }
\ No newline at end of file
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// existingProject describes a Django project found on disk, for commands that
+// operate on a project after it has been created.
+type existingProject struct {
+	root           string
+	settingsModule string
+	packageName    string
+	settingsPath   string
+}
+
+var settingsModulePattern = regexp.MustCompile(`DJANGO_SETTINGS_MODULE['"]\s*,\s*['"]([\w.]+)['"]`)
+
+// findProject walks up from dir until it finds a manage.py and reads the
+// settings module it configures.
+func findProject(dir string) (*existingProject, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve directory: %v", err)
+	}
+
+	for {
+		managePath := filepath.Join(dir, "manage.py")
+		if _, err := os.Stat(managePath); err == nil {
+			return loadProject(dir, managePath)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, fmt.Errorf("no manage.py found; run this command inside a Django project")
+		}
+		dir = parent
+	}
+}
+
+func loadProject(root, managePath string) (*existingProject, error) {
+	manageContent, err := os.ReadFile(managePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manage.py: %v", err)
+	}
+	match := settingsModulePattern.FindStringSubmatch(string(manageContent))
+	if match == nil {
+		return nil, fmt.Errorf("could not find DJANGO_SETTINGS_MODULE in %s", managePath)
+	}
+
+	p := &existingProject{
+		root:           root,
+		settingsModule: match[1],
+		packageName:    strings.Split(match[1], ".")[0],
+	}
+
+	modulePath := filepath.Join(append([]string{root}, strings.Split(match[1], ".")...)...)
+	for _, candidate := range []string{modulePath + ".py", filepath.Join(modulePath, "__init__.py")} {
+		if _, err := os.Stat(candidate); err == nil {
+			p.settingsPath = candidate
+			break
+		}
+	}
+	if p.settingsPath == "" {
+		return nil, fmt.Errorf("settings module '%s' not found in %s", match[1], root)
+	}
+
+	if _, err := os.Stat(getPythonPath(root)); err != nil {
+		return nil, fmt.Errorf("no virtual environment found at %s", filepath.Join(root, ".venv"))
+	}
+	return p, nil
+}
+
+func (p *existingProject) urlsPath() string {
+	return filepath.Join(p.root, p.packageName, "urls.py")
+}
+
+func (p *existingProject) apiPath() string {
+	return filepath.Join(p.root, p.packageName, "api.py")
+}
+
+func (p *existingProject) hasGlobalTemplates() bool {
+	_, err := os.Stat(filepath.Join(p.root, "templates", "base.html"))
+	return err == nil
+}
+
+func (p *existingProject) hasMediaFiles() bool {
+	settingsContent, err := os.ReadFile(p.settingsPath)
+	return err == nil && strings.Contains(string(settingsContent), "MEDIA_ROOT")
+}
+
+// hasAPIExtras reports whether the project was created with the API extras
+// option, so generated viewsets get filter, search and ordering fields.
+func (p *existingProject) hasAPIExtras() bool {
+	settingsContent, err := os.ReadFile(p.settingsPath)
+	return err == nil && strings.Contains(string(settingsContent), "'django_filters'")
+}
+
+// apiFramework reports which framework serves the project's api.py.
+func (p *existingProject) apiFramework() string {
+	apiContent, err := os.ReadFile(p.apiPath())
+	if err == nil && strings.Contains(string(apiContent), "NinjaAPI(") {
+		return apiFrameworkNinja
+	}
+	return apiFrameworkDRF
+}
+
+// apiVersioning reports the REST Framework versioning strategy configured in
+// the settings, or "" when there is none.
+func (p *existingProject) apiVersioning() string {
+	settingsContent, err := os.ReadFile(p.settingsPath)
+	if err != nil {
+		return ""
+	}
+	for strategy, class := range apiVersioningClasses {
+		if strings.Contains(string(settingsContent), "'"+class+"'") {
+			return strategy
+		}
+	}
+	return ""
+}
+
+// apiVersionPaths returns the router modules of every API version: api.py
+// and the api_<version>.py modules added after it.
+func (p *existingProject) apiVersionPaths() []string {
+	paths := []string{p.apiPath()}
+	later, _ := filepath.Glob(filepath.Join(p.root, p.packageName, "api_v*.py"))
+	return append(paths, later...)
+}
+
+// addAPIRoute adds a route to every API version. New endpoints do not break
+// existing clients, so older versions get them too.
+func (p *existingProject) addAPIRoute(importLine, registerLine string) error {
+	for _, apiPath := range p.apiVersionPaths() {
+		apiContent, err := os.ReadFile(apiPath)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %v", filepath.Base(apiPath), err)
+		}
+		updatedAPI, err := addAPIRoute(string(apiContent), importLine, registerLine)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(apiPath, []byte(updatedAPI), 0644); err != nil {
+			return fmt.Errorf("failed to update %s: %v", filepath.Base(apiPath), err)
+		}
+	}
+	return nil
+}
+
+// hasNestedApps reports whether the project keeps its apps in the apps
+// package rather than next to manage.py.
+func (p *existingProject) hasNestedApps() bool {
+	_, err := os.Stat(filepath.Join(p.root, appsPackage, "__init__.py"))
+	if err != nil {
+		return false
+	}
+	// An app that happens to be called "apps" is not the apps package.
+	_, err = os.Stat(filepath.Join(p.root, appsPackage, "apps.py"))
+	return os.IsNotExist(err)
+}
+
+// model returns a Model configured like the one that generated the project,
+// so the creation steps can be reused against it.
+func (p *existingProject) model() *Model {
+	return &Model{
+		projectName:      filepath.Base(p.root),
+		packageName:      p.packageName,
+		createTemplates:  p.hasGlobalTemplates(),
+		setupMedia:       p.hasMediaFiles(),
+		defaultBlueprint: blueprintPages,
+		nestedApps:       p.hasNestedApps(),
+		apiExtras:        p.hasAPIExtras(),
+		apiFramework:     p.apiFramework(),
+		apiVersioning:    p.apiVersioning(),
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+)
+
+// runGenerate dispatches "generate <kind>", which adds code to an app of the
+// project in the current directory.
+func runGenerate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: django-forge generate model|admin|page <app> ... or generate client")
+	}
+	switch args[0] {
+	case "model":
+		return runGenerateModel(args[1:])
+	case "admin":
+		return runGenerateAdmin(args[1:])
+	case "page":
+		return runGeneratePage(args[1:])
+	case "client":
+		return runGenerateClient(args[1:])
+	default:
+		return fmt.Errorf("unknown generator '%s' (available: model, admin, page, client)", args[0])
+	}
+}
+
+func runGenerateModel(args []string) error {
+	fs := flag.NewFlagSet("generate model", flag.ContinueOnError)
+	withAPI := fs.Bool("api", false, "Add the model's API (serializer and viewset, or Ninja schemas and router) and route it in the project's api.py")
+	withCRUD := fs.Bool("crud", false, "Add a ModelForm, CRUD views, templates and URLs")
+	timestamps := fs.Bool("timestamps", false, "Add created_at and updated_at fields")
+	noMigrate := fs.Bool("no-migrations", false, "Skip makemigrations")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) < 2 {
+		return fmt.Errorf("usage: django-forge generate model <app> <Model> [field:type[:arg][:optional][:unique]...] [--api] [--crud] [--timestamps]")
+	}
+	appName, modelName := positional[0], positional[1]
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %v", err)
+	}
+	project, err := findProject(wd)
+	if err != nil {
+		return err
+	}
+	spec, err := newModelSpec(appName, modelName, positional[2:], *timestamps)
+	if err != nil {
+		return err
+	}
+	m := project.model()
+	spec.module = m.appModule(appName)
+	spec.filters = m.apiExtras
+
+	modelsPath := filepath.Join(m.appDir(project.root, appName), "models.py")
+	modelsContent, err := os.ReadFile(modelsPath)
+	if err != nil {
+		return fmt.Errorf("app '%s' not found in %s (create it with add-app first)", appName, project.root)
+	}
+	if regexp.MustCompile(`(?m)^class ` + modelName + `\b`).Match(modelsContent) {
+		return fmt.Errorf("model '%s' already exists in %s", modelName, modelsPath)
+	}
+	if _, err := os.Stat(project.apiPath()); *withAPI && err != nil {
+		return fmt.Errorf("--api needs a project created with an API (%s not found)", project.apiPath())
+	}
+
+	if err := spec.writeModel(project.root, *withCRUD); err != nil {
+		return err
+	}
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Added %s to %s and registered it in the admin.", modelName, filepath.Join(m.appDir("", appName), "models.py")))
+
+	if spec.hasImageFields() {
+		if err := m.installPillow(project.root); err != nil {
+			return err
+		}
+	}
+
+	if err := m.writeSampleData(spec, project.root); err != nil {
+		return err
+	}
+	if len(spec.unfillableFields()) == 0 {
+		m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Added sample %s objects to %s; load them with 'python manage.py loaddata %s'.",
+			modelName, filepath.Join(m.appDir("", appName), "fixtures", appName+".json"), appName))
+	}
+
+	if *withAPI {
+		if err := m.api().writeModelAPI(spec, project.root); err != nil {
+			return err
+		}
+		importLine, registerLine := m.api().route(spec, spec.urlSegment())
+		if err := project.addAPIRoute(importLine, registerLine); err != nil {
+			return err
+		}
+		m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Added the %s API at %s%s/.", modelName, m.apiRoot(), spec.urlSegment()))
+	}
+
+	if *withCRUD {
+		createdUrls, err := spec.writeCRUD(project.root, spec.urlSegment()+"/")
+		if err != nil {
+			return err
+		}
+		if createdUrls {
+			if err := mountApp(project, appName); err != nil {
+				return err
+			}
+		}
+		m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Added %sForm, CRUD views and templates at /%s/%s/.", modelName, appName, spec.urlSegment()))
+	}
+
+	if !*noMigrate {
+		cmd := exec.Command(getPythonPath(project.root), "manage.py", "makemigrations", appName)
+		cmd.Dir = project.root
+		if output, err := cmd.CombinedOutput(); err != nil {
+			printStepMessages(m.stepMessages)
+			return fmt.Errorf("failed to run makemigrations for %s: %v\nOutput: %s", appName, err, string(output))
+		}
+		m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Created migrations for %s.", appName))
+	}
+
+	printStepMessages(m.stepMessages)
+	return nil
+}
+
+// mountApp includes the app's URLconf in the project's urls.py under the app
+// name.
+func mountApp(project *existingProject, appName string) error {
+	urlsContent, err := os.ReadFile(project.urlsPath())
+	if err != nil {
+		return fmt.Errorf("failed to read urls.py: %v", err)
+	}
+	updatedUrls, err := addURLPattern(string(urlsContent), project.model().appURLPattern(appName))
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(project.urlsPath(), []byte(updatedUrls), 0644); err != nil {
+		return fmt.Errorf("failed to update urls.py: %v", err)
+	}
+	return nil
+}
+
+// parseInterspersed parses flags that appear before, between or after the
+// positional arguments; flag.Parse alone stops at the first positional one.
+func parseInterspersed(fs *flag.FlagSet, arguments []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(arguments); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		arguments = fs.Args()[1:]
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 )
 
 func (m *Model) initializeGitRepository(projectPath string) error {
@@ -12,12 +13,17 @@ func (m *Model) initializeGitRepository(projectPath string) error {
 		return nil
 	}
 
-	gitCmd := exec.Command("git", "init")
-	gitCmd.Dir = projectPath
-	if output, err := gitCmd.CombinedOutput(); err != nil {
-		return fmt.Errorf("failed to initialize Git repository: %v\nOutput: %s", err, string(output))
+	// Scaffolding into an existing checkout keeps its repository.
+	if _, err := os.Stat(filepath.Join(projectPath, ".git")); err == nil {
+		m.stepMessages = append(m.stepMessages, "💡 Using the existing Git repository.")
+	} else {
+		gitCmd := exec.Command("git", "init")
+		gitCmd.Dir = projectPath
+		if output, err := gitCmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to initialize Git repository: %v\nOutput: %s", err, string(output))
+		}
+		m.stepMessages = append(m.stepMessages, "✅ Git repository initialized.")
 	}
-	m.stepMessages = append(m.stepMessages, "✅ Git repository initialized.")
 
 	gitignoreContent := `# Django
 *.log
@@ -46,10 +52,49 @@ ENV/
 .DS_Store
 Thumbs.db
 `
-	if err := os.WriteFile(filepath.Join(projectPath, ".gitignore"), []byte(gitignoreContent), 0644); err != nil {
-		return fmt.Errorf("failed to create .gitignore: %v", err)
+	gitignorePath := filepath.Join(projectPath, ".gitignore")
+	existing, err := os.ReadFile(gitignorePath)
+	if err != nil {
+		if err := os.WriteFile(gitignorePath, []byte(gitignoreContent), 0644); err != nil {
+			return fmt.Errorf("failed to create .gitignore: %v", err)
+		}
+		m.stepMessages = append(m.stepMessages, "✅ .gitignore file created.")
+		return nil
+	}
+
+	missing := missingGitignoreEntries(string(existing), gitignoreContent)
+	if missing == "" {
+		return nil
+	}
+	updated := strings.TrimRight(string(existing), "\n") + "\n\n" + missing
+	if err := os.WriteFile(gitignorePath, []byte(updated), 0644); err != nil {
+		return fmt.Errorf("failed to update .gitignore: %v", err)
 	}
-	m.stepMessages = append(m.stepMessages, "✅ .gitignore file created.")
+	m.stepMessages = append(m.stepMessages, "✅ Added the Django entries to the existing .gitignore.")
 
 	return nil
-}
\ No newline at end of file
+}
+
+// missingGitignoreEntries returns the sections of entries whose patterns are
+// not yet in existing, with each section keeping its comment header.
+func missingGitignoreEntries(existing, entries string) string {
+	present := make(map[string]bool)
+	for _, line := range strings.Split(existing, "\n") {
+		present[strings.TrimSpace(line)] = true
+	}
+
+	var missing []string
+	for _, section := range strings.Split(strings.TrimSpace(entries), "\n\n") {
+		lines := strings.Split(section, "\n")
+		var kept []string
+		for _, line := range lines[1:] {
+			if !present[line] {
+				kept = append(kept, line)
+			}
+		}
+		if len(kept) > 0 {
+			missing = append(missing, lines[0]+"\n"+strings.Join(kept, "\n")+"\n")
+		}
+	}
+	return strings.Join(missing, "\n")
+}
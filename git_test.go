@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMissingGitignoreEntries(t *testing.T) {
+	entries := "# Django\n*.pyc\ndb.sqlite3\n\n# OS\n.DS_Store\n"
+	tests := []struct {
+		name     string
+		existing string
+		want     string
+	}{
+		{name: "empty", existing: "", want: "# Django\n*.pyc\ndb.sqlite3\n\n# OS\n.DS_Store\n"},
+		{name: "some present", existing: "node_modules/\n*.pyc\n", want: "# Django\ndb.sqlite3\n\n# OS\n.DS_Store\n"},
+		{name: "whole section present", existing: ".DS_Store\n", want: "# Django\n*.pyc\ndb.sqlite3\n"},
+		{name: "all present", existing: "*.pyc\ndb.sqlite3\n.DS_Store\n", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := missingGitignoreEntries(tt.existing, entries); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitializeGitRepositoryInExistingCheckout(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	gitignorePath := filepath.Join(dir, ".gitignore")
+	if err := os.WriteFile(gitignorePath, []byte("secret.txt\n*.pyc\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	m := &Model{initializeGit: true}
+	if err := m.initializeGitRepository(dir); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(gitignorePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(content), "secret.txt\n*.pyc\n\n# Django\n") {
+		t.Errorf("existing entries were not kept:\n%s", content)
+	}
+	if strings.Count(string(content), "*.pyc") != 1 || !strings.Contains(string(content), ".venv/") {
+		t.Errorf("expected the missing entries once:\n%s", content)
+	}
+
+	if err := m.initializeGitRepository(dir); err != nil {
+		t.Fatal(err)
+	}
+	again, _ := os.ReadFile(gitignorePath)
+	if string(again) != string(content) {
+		t.Errorf("a second run changed .gitignore:\n%s", again)
+	}
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// graphQLSchemaFile is the project module holding the Strawberry schema.
+const graphQLSchemaFile = "schema.py"
+
+// graphQLModels returns the example models of the apps that have one, which
+// the schema exposes.
+func (m *Model) graphQLModels() []*modelSpec {
+	var specs []*modelSpec
+	for _, appName := range m.appsWithBlueprint(blueprintCRUD, blueprintAPI) {
+		specs = append(specs, m.blueprintModel(appName))
+	}
+	return specs
+}
+
+func (m *Model) setupGraphQLEndpoint(projectPath, settingsPath string) error {
+	if !m.setupGraphQL {
+		return nil
+	}
+	m.updateProgress("Setting up GraphQL...")
+
+	cmd := exec.Command(getPythonPath(projectPath), "-m", "pip", "install", "strawberry-graphql-django")
+	cmd.Dir = projectPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to install strawberry-graphql-django: %v\nOutput: %s", err, string(output))
+	}
+	m.stepMessages = append(m.stepMessages, "✅ Strawberry Django installed.")
+
+	settingsContent, err := os.ReadFile(settingsPath)
+	if err != nil {
+		return fmt.Errorf("failed to read settings.py for GraphQL: %v", err)
+	}
+	settingsStr, err := addToListInSettingsPy(string(settingsContent), "INSTALLED_APPS", "strawberry_django")
+	if err != nil {
+		return fmt.Errorf("failed to add strawberry_django to INSTALLED_APPS: %v", err)
+	}
+	if err := os.WriteFile(settingsPath, []byte(settingsStr), 0644); err != nil {
+		return fmt.Errorf("failed to write updated settings.py: %v", err)
+	}
+
+	specs := m.graphQLModels()
+	for _, spec := range specs {
+		typesPath := filepath.Join(moduleDir(projectPath, spec.appModule()), "types.py")
+		if err := writePythonSource(typesPath, spec.graphQLTypesSource()); err != nil {
+			return err
+		}
+	}
+	schemaPath := filepath.Join(projectPath, m.packageName, graphQLSchemaFile)
+	if err := os.WriteFile(schemaPath, []byte(m.graphQLSchemaSource(specs).String()), 0644); err != nil {
+		return fmt.Errorf("failed to create %s: %v", graphQLSchemaFile, err)
+	}
+	if err := m.writeProjectUrls(projectPath); err != nil {
+		return err
+	}
+
+	if len(specs) == 0 {
+		m.stepMessages = append(m.stepMessages, "✅ Serving GraphQL at /graphql/ with a hello query; add types for your models to "+filepath.Join(m.packageName, graphQLSchemaFile)+".")
+	} else {
+		m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Serving GraphQL at /graphql/ with queries and mutations for %d model(s); GraphiQL is enabled while DEBUG is on.", len(specs)))
+	}
+	return nil
+}
+
+// graphQLTypesSource renders the Strawberry output type of the model and the
+// inputs its create and update mutations take.
+func (s *modelSpec) graphQLTypesSource() pythonSource {
+	var b strings.Builder
+	fmt.Fprintf(&b, "@strawberry_django.type(%[1]s)\nclass %[1]sType:\n    id: auto\n", s.name)
+	for _, field := range s.fields {
+		fmt.Fprintf(&b, "    %s: auto\n", field.name)
+	}
+	if s.timestamps {
+		b.WriteString("    created_at: auto\n    updated_at: auto\n")
+	}
+
+	fmt.Fprintf(&b, "\n\n@strawberry_django.input(%[1]s)\nclass %[1]sInput:\n", s.name)
+	for _, field := range s.inputFields() {
+		fmt.Fprintf(&b, "    %s: auto\n", field.name)
+	}
+	fmt.Fprintf(&b, "\n\n@strawberry_django.partial(%[1]s)\nclass %[1]sPartialInput(%[1]sInput):\n    id: auto\n", s.name)
+
+	return pythonSource{
+		imports: []string{"import strawberry_django", "from strawberry import auto", "from .models import " + s.name},
+		body:    b.String(),
+	}
+}
+
+// graphQLSchemaSource renders the project's schema: a list and a single
+// object query plus create, update and delete mutations per model. Without
+// models the schema only answers a hello query, as GraphQL needs at least one
+// field.
+func (m *Model) graphQLSchemaSource(specs []*modelSpec) pythonSource {
+	if len(specs) == 0 {
+		return pythonSource{
+			imports: []string{"import strawberry"},
+			body: fmt.Sprintf(`@strawberry.type
+class Query:
+    @strawberry.field
+    def hello(self) -> str:
+        return 'Hello from %s!'
+
+
+schema = strawberry.Schema(query=Query)
+`, m.projectName),
+		}
+	}
+
+	imports := []string{
+		"import strawberry",
+		"import strawberry_django",
+		"from strawberry_django import mutations",
+		"from strawberry_django.optimizer import DjangoOptimizerExtension",
+	}
+	var queries, mutationFields strings.Builder
+	for _, spec := range specs {
+		imports = append(imports,
+			fmt.Sprintf("from %s.models import %s", spec.appModule(), spec.name),
+			fmt.Sprintf("from %[1]s.types import %[2]sInput, %[2]sPartialInput, %[2]sType", spec.appModule(), spec.name),
+		)
+		single := snakeCase(spec.name)
+		fmt.Fprintf(&queries, "    %s: list[%sType] = strawberry_django.field()\n", pluralize(single), spec.name)
+		fmt.Fprintf(&queries, "    %s: %sType = strawberry_django.field()\n", single, spec.name)
+		fmt.Fprintf(&mutationFields, `    create_%[1]s: %[2]sType = mutations.create(%[2]sInput)
+    update_%[1]s: %[2]sType = mutations.update(%[2]sPartialInput)
+
+    @strawberry.mutation
+    def delete_%[1]s(self, id: strawberry.ID) -> bool:
+        deleted, _ = %[2]s.objects.filter(pk=id).delete()
+        return deleted > 0
+
+`, single, spec.name)
+	}
+
+	body := "@strawberry.type\nclass Query:\n" + queries.String() +
+		"\n\n@strawberry.type\nclass Mutation:\n" + strings.TrimSuffix(mutationFields.String(), "\n") +
+		"\n\nschema = strawberry.Schema(query=Query, mutation=Mutation, extensions=[DjangoOptimizerExtension])\n"
+	return pythonSource{imports: imports, body: body}
+}
+
+// addGraphQLLinks links the GraphQL endpoint next to the admin links of the
+// welcome page and the footer.
+func (m *Model) addGraphQLLinks(content string) string {
+	if !m.setupGraphQL {
+		return content
+	}
+	footerAdmin := `<li><a href="/admin/" class="text-gray-400 hover:text-white transition-colors text-sm">{% translate "Admin Panel" %}</a></li>`
+	content = strings.Replace(content, footerAdmin, footerAdmin+"\n"+
+		`                            <li><a href="/graphql/" class="text-gray-400 hover:text-white transition-colors text-sm">{% translate "GraphQL" %}</a></li>`, 1)
+
+	ctaAdmin := `<a href="/admin/" class="border border-gray-700 text-white px-8 py-4 rounded-md font-semibold hover:border-gray-600 hover:bg-gray-900 transition-colors duration-200">
+                    {% translate "Admin Panel" %}
+                </a>`
+	return strings.Replace(content, ctaAdmin, ctaAdmin+`
+                <a href="/graphql/" class="border border-gray-700 text-white px-8 py-4 rounded-md font-semibold hover:border-gray-600 hover:bg-gray-900 transition-colors duration-200">
+                    {% translate "GraphQL" %}
+                </a>`, 1)
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/huh"
+)
+
+type language struct {
+	code string
+	name string
+}
+
+var availableLanguages = []language{
+	{"en", "English"},
+	{"de", "German"},
+	{"fr", "French"},
+	{"es", "Spanish"},
+	{"it", "Italian"},
+	{"pt-br", "Brazilian Portuguese"},
+	{"nl", "Dutch"},
+	{"pl", "Polish"},
+	{"ru", "Russian"},
+	{"ar", "Arabic"},
+	{"hi", "Hindi"},
+	{"ja", "Japanese"},
+	{"ko", "Korean"},
+	{"zh-hans", "Simplified Chinese"},
+}
+
+func languageOptions() []huh.Option[string] {
+	options := make([]huh.Option[string], 0, len(availableLanguages))
+	for _, lang := range availableLanguages {
+		options = append(options, huh.NewOption(fmt.Sprintf("%s (%s)", lang.name, lang.code), lang.code))
+	}
+	return options
+}
+
+func languageName(code string) string {
+	for _, lang := range availableLanguages {
+		if lang.code == code {
+			return lang.name
+		}
+	}
+	return code
+}
+
+// projectLanguages returns the default language followed by the additional
+// ones, without duplicates.
+func (m *Model) projectLanguages() []string {
+	languages := []string{m.defaultLanguage}
+	for _, code := range m.extraLanguages {
+		if !contains(languages, code) {
+			languages = append(languages, code)
+		}
+	}
+	return languages
+}
+
+// localeName converts a language code such as "pt-br" into the locale
+// directory name makemessages expects ("pt_BR").
+func localeName(code string) string {
+	lang, country, found := strings.Cut(strings.ToLower(code), "-")
+	if !found {
+		return lang
+	}
+	if len(country) > 2 {
+		return lang + "_" + strings.ToUpper(country[:1]) + country[1:]
+	}
+	return lang + "_" + strings.ToUpper(country)
+}
+
+// detectTimeZone returns the host's IANA time zone name, falling back to UTC
+// when it cannot be determined.
+func detectTimeZone() string {
+	candidates := []string{strings.TrimPrefix(os.Getenv("TZ"), ":")}
+	if runtime.GOOS != "windows" {
+		if data, err := os.ReadFile("/etc/timezone"); err == nil {
+			candidates = append(candidates, strings.TrimSpace(string(data)))
+		}
+		if target, err := filepath.EvalSymlinks("/etc/localtime"); err == nil {
+			candidates = append(candidates, target)
+		}
+	}
+
+	for _, tz := range candidates {
+		if idx := strings.LastIndex(tz, "zoneinfo/"); idx != -1 {
+			tz = tz[idx+len("zoneinfo/"):]
+			tz = strings.TrimPrefix(strings.TrimPrefix(tz, "posix/"), "right/")
+		}
+		if tz == "" || filepath.IsAbs(tz) || tz == "Local" {
+			continue
+		}
+		if _, err := time.LoadLocation(tz); err == nil {
+			return tz
+		}
+	}
+	return "UTC"
+}
+
+func (m *Model) configureI18n(projectPath, settingsPath string) error {
+	if !m.setupI18n {
+		return nil
+	}
+
+	m.updateProgress("Configuring internationalization...")
+	m.timeZone = detectTimeZone()
+
+	settingsContentBytes, err := os.ReadFile(settingsPath)
+	if err != nil {
+		return fmt.Errorf("failed to read settings.py for i18n: %v", err)
+	}
+	settingsStr := string(settingsContentBytes)
+
+	settingsStr = strings.Replace(settingsStr, "LANGUAGE_CODE = 'en-us'", fmt.Sprintf("LANGUAGE_CODE = '%s'", m.defaultLanguage), 1)
+	settingsStr = strings.Replace(settingsStr, "TIME_ZONE = 'UTC'", fmt.Sprintf("TIME_ZONE = '%s'", m.timeZone), 1)
+
+	if !strings.Contains(settingsStr, "gettext_lazy") {
+		settingsStr = strings.Replace(settingsStr,
+			"from pathlib import Path",
+			"from pathlib import Path\n\nfrom django.utils.translation import gettext_lazy as _",
+			1)
+	}
+
+	if !strings.Contains(settingsStr, "LOCALE_PATHS") {
+		var languages strings.Builder
+		languages.WriteString("LANGUAGES = [\n")
+		for _, code := range m.projectLanguages() {
+			languages.WriteString(fmt.Sprintf("    ('%s', _('%s')),\n", code, languageName(code)))
+		}
+		languages.WriteString("]\n\nLOCALE_PATHS = [\n    BASE_DIR / 'locale',\n]\n")
+
+		marker := "USE_I18N = True\n"
+		if strings.Contains(settingsStr, marker) {
+			settingsStr = strings.Replace(settingsStr, marker, marker+"\n"+languages.String(), 1)
+		} else {
+			settingsStr += "\n" + languages.String()
+		}
+	}
+
+	settingsStr, err = insertIntoListInSettingsPy(settingsStr, "MIDDLEWARE",
+		"django.middleware.locale.LocaleMiddleware", "django.middleware.common.CommonMiddleware")
+	if err != nil {
+		return fmt.Errorf("failed to add LocaleMiddleware to MIDDLEWARE: %v", err)
+	}
+
+	if err := os.WriteFile(settingsPath, []byte(settingsStr), 0644); err != nil {
+		return fmt.Errorf("failed to write updated settings.py: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(projectPath, "locale"), 0755); err != nil {
+		return fmt.Errorf("failed to create locale directory: %v", err)
+	}
+
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Configured i18n (%s, time zone %s).", strings.Join(m.projectLanguages(), ", "), m.timeZone))
+	return nil
+}
+
+func (m *Model) runMakeMessages(projectPath string) error {
+	if !m.setupI18n {
+		return nil
+	}
+
+	m.updateProgress("Generating translation files...")
+
+	if !isCommandAvailable("xgettext") {
+		m.stepMessages = append(m.stepMessages, "⚠️  Warning: GNU gettext not found. Install it and run 'manage.py makemessages' to create translation files.")
+		return nil
+	}
+
+	args := []string{"manage.py", "makemessages", "--ignore", ".venv/*", "--ignore", "node_modules/*"}
+	for _, code := range m.projectLanguages() {
+		args = append(args, "-l", localeName(code))
+	}
+	cmd := exec.Command(getPythonPath(projectPath), args...)
+	cmd.Dir = projectPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		m.stepMessages = append(m.stepMessages, fmt.Sprintf("⚠️  Warning: Failed to generate translation files: %v\nOutput: %s", err, string(output)))
+		return nil
+	}
+	m.stepMessages = append(m.stepMessages, "✅ Created translation files in locale/.")
+	return nil
+}
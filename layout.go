@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// appsPackage is the package that holds the project's apps with the nested
+// layout, so that blog lives in apps/blog and is imported as apps.blog.
+const appsPackage = "apps"
+
+// App layouts accepted by --layout.
+const (
+	layoutFlat = "flat"
+	layoutApps = "apps"
+)
+
+var appConfigNamePattern = regexp.MustCompile(`(?m)^(\s+name = )['"][\w.]+['"]`)
+
+// appModule returns the dotted import path of an app: its name, or
+// apps.<name> with the nested layout. The app label stays the bare name
+// either way, so template directories, URL namespaces and model references
+// such as 'blog.Post' do not change.
+func (m *Model) appModule(appName string) string {
+	if m.nestedApps {
+		return appsPackage + "." + appName
+	}
+	return appName
+}
+
+// appDir returns the directory of an app inside projectPath. An empty
+// projectPath gives the path relative to the project.
+func (m *Model) appDir(projectPath, appName string) string {
+	return moduleDir(projectPath, m.appModule(appName))
+}
+
+func moduleDir(projectPath, module string) string {
+	return filepath.Join(append([]string{projectPath}, strings.Split(module, ".")...)...)
+}
+
+// prepareAppDir creates the apps package and the app's directory inside it,
+// which startapp requires to exist before it fills it.
+func (m *Model) prepareAppDir(projectPath, appName string) error {
+	if !m.nestedApps {
+		return nil
+	}
+	if err := os.MkdirAll(m.appDir(projectPath, appName), 0755); err != nil {
+		return fmt.Errorf("failed to create directory for app '%s': %v", appName, err)
+	}
+	initPath := filepath.Join(projectPath, appsPackage, "__init__.py")
+	if _, err := os.Stat(initPath); os.IsNotExist(err) {
+		if err := os.WriteFile(initPath, nil, 0644); err != nil {
+			return fmt.Errorf("failed to create %s/__init__.py: %v", appsPackage, err)
+		}
+	}
+	return nil
+}
+
+// setAppConfigName points the AppConfig that startapp wrote at the app's
+// import path; startapp always uses the bare app name.
+func (m *Model) setAppConfigName(projectPath, appName string) error {
+	if !m.nestedApps {
+		return nil
+	}
+	appsPath := filepath.Join(m.appDir(projectPath, appName), "apps.py")
+	content, err := os.ReadFile(appsPath)
+	if err != nil {
+		return fmt.Errorf("failed to read apps.py for app %s: %v", appName, err)
+	}
+	if !appConfigNamePattern.Match(content) {
+		return fmt.Errorf("could not find the AppConfig name in %s", appsPath)
+	}
+	updated := appConfigNamePattern.ReplaceAllString(string(content), fmt.Sprintf("${1}'%s'", m.appModule(appName)))
+	if err := os.WriteFile(appsPath, []byte(updated), 0644); err != nil {
+		return fmt.Errorf("failed to update apps.py for app %s: %v", appName, err)
+	}
+	return nil
+}
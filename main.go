@@ -4,33 +4,83 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// stringListFlag collects the values of a flag that may be repeated.
+type stringListFlag []string
+
+func (f *stringListFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringListFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
 type CLIArgs struct {
 	ProjectName     string
+	PackageName     string
+	Apps            stringListFlag
 	DjangoVersion   string
 	SkipInteractive bool
 	Help            bool
 	Install         bool
+	Superuser       string
+	SuperuserEmail  string
+	SuperuserPass   string
+	Dir             string
+	Force           bool
+	InPlace         bool
+	Layout          string
+	APIOnly         bool
+	OpenAPI         bool
+	APIExtras       bool
+	CORS            bool
+	GraphQL         bool
+	APIFramework    string
+	APIAuth         string
+	APIPermission   string
+	APIVersioning   string
+	SampleRows      int
 }
 
-func parseArgs() CLIArgs {
+// defineFlags registers the project creation flags on fs. The returned
+// CLIArgs is filled in by fs.Parse, which may be called more than once.
+func defineFlags(fs *flag.FlagSet) *CLIArgs {
 	var args CLIArgs
 
-	flag.StringVar(&args.ProjectName, "name", "", "Project name")
-	flag.StringVar(&args.ProjectName, "n", "", "Project name (shorthand)")
-	flag.StringVar(&args.DjangoVersion, "version", "", "Django version")
-	flag.StringVar(&args.DjangoVersion, "v", "", "Django version (shorthand)")
-	flag.BoolVar(&args.SkipInteractive, "auto", false, "Skip interactive mode with defaults")
-	flag.BoolVar(&args.Help, "help", false, "Show help")
-	flag.BoolVar(&args.Help, "h", false, "Show help (shorthand)")
-	flag.BoolVar(&args.Install, "install", false, "Install CLI globally (Windows only)")
-
-	flag.Parse()
+	fs.StringVar(&args.ProjectName, "name", "", "Project name")
+	fs.StringVar(&args.ProjectName, "n", "", "Project name (shorthand)")
+	fs.StringVar(&args.PackageName, "package", "", "Python package name (default: derived from project name)")
+	fs.Var(&args.Apps, "app", "App to create, optionally as name:blueprint (repeatable)")
+	fs.StringVar(&args.DjangoVersion, "version", "", "Django version")
+	fs.StringVar(&args.DjangoVersion, "v", "", "Django version (shorthand)")
+	fs.BoolVar(&args.SkipInteractive, "auto", false, "Skip interactive mode with defaults")
+	fs.BoolVar(&args.Help, "help", false, "Show help")
+	fs.BoolVar(&args.Help, "h", false, "Show help (shorthand)")
+	fs.BoolVar(&args.Install, "install", false, "Install CLI globally (Windows only)")
+	fs.StringVar(&args.Superuser, "superuser", "", "Superuser username to create after migrations")
+	fs.StringVar(&args.SuperuserEmail, "superuser-email", "", "Superuser email address")
+	fs.StringVar(&args.SuperuserPass, "superuser-password", "", "Superuser password (or set DJANGO_SUPERUSER_PASSWORD)")
+	fs.StringVar(&args.Dir, "dir", "", "Parent directory to create the project in (default: current directory)")
+	fs.BoolVar(&args.Force, "force", false, "Overwrite existing files in the target directory")
+	fs.BoolVar(&args.APIOnly, "api-only", false, "JSON API project without HTML templates (creates an api app if no --app is given)")
+	fs.BoolVar(&args.OpenAPI, "openapi", false, "Serve an OpenAPI schema with Swagger UI and ReDoc (drf-spectacular)")
+	fs.BoolVar(&args.APIExtras, "api-extras", false, "Filtering, search, ordering and throttling for the API (django-filter)")
+	fs.BoolVar(&args.CORS, "cors", false, "Allow cross-origin requests from separate frontends (django-cors-headers)")
+	fs.BoolVar(&args.GraphQL, "graphql", false, "GraphQL endpoint at /graphql/ for the example models (Strawberry Django)")
+	fs.StringVar(&args.APIFramework, "api-framework", apiFrameworkDRF, "API framework: drf (Django REST Framework) or ninja (Django Ninja)")
+	fs.StringVar(&args.APIAuth, "api-auth", apiAuthSession, "API authentication: session, token or jwt")
+	fs.StringVar(&args.APIPermission, "api-permission", "IsAuthenticatedOrReadOnly", "Default API permission class: IsAuthenticatedOrReadOnly, IsAuthenticated or AllowAny")
+	fs.StringVar(&args.APIVersioning, "api-versioning", apiVersioningURL, "API versioning: url, namespace or header")
+	fs.IntVar(&args.SampleRows, "sample-rows", 0, "Fake rows to generate per crud/api model with factory_boy and Faker")
+	fs.StringVar(&args.Layout, "layout", layoutFlat, "App layout: flat (next to manage.py) or apps (in apps/<name>)")
 
-	return args
+	return &args
 }
 
 func showHelp() {
@@ -38,27 +88,89 @@ func showHelp() {
 
 Usage:
   django-forge [flags]
+  django-forge <command> [arguments]
+
+Commands:
+  new [dir|.]            Create a project; '.' scaffolds into the current directory
+  add-app <name>[:bp]    Add an app to the project in the current directory
+  add api-version <v2>   Add an API version, starting from the routes of the latest one
+  generate model <app> <Model> [field:type...]
+                         Add a model and its admin; --api, --crud, --timestamps
+  generate admin <app>   Register the app's models that are missing from the admin
+  generate page <app> <name>
+                         Add a page (view, URL, template) linked from the base nav
+  generate client         Write a typed TypeScript client of the API to frontend/src/api;
+                         --out dir, --schema openapi.json
 
 Flags:
   -n, --name string      Project name
   -v, --version string   Django version (default: latest)
+  --package string       Python package name (default: project name with '-' as '_')
+  --app string           App to create; name:crud picks a blueprint (empty, pages, crud, api)
   --auto                 Skip interactive mode with defaults
   --install             Install CLI globally (Windows only)
+  --superuser string     Create a superuser with this username
+  --superuser-email      Superuser email address
+  --superuser-password   Superuser password (prefer DJANGO_SUPERUSER_PASSWORD)
+  --dir string           Parent directory for the project (default: current directory)
+  --force                Overwrite existing files in the target directory
+  --api-only             JSON API project without HTML templates; adds an api app if no --app is given
+  --openapi              OpenAPI schema at /api/schema/ with Swagger UI and ReDoc (drf-spectacular)
+  --api-extras           Filtering (django-filter), search, ordering and throttling for the API
+  --cors                 Allow cross-origin requests from a separate frontend (django-cors-headers)
+  --graphql              GraphQL endpoint at /graphql/ with GraphiQL in debug (Strawberry Django)
+  --api-framework string API framework: drf (default, Django REST Framework) or ninja (Django Ninja)
+  --api-auth string       API authentication: session (default), token or jwt (drf only)
+  --api-permission string
+                         Default API permission: IsAuthenticatedOrReadOnly (default), IsAuthenticated or AllowAny
+  --api-versioning string
+                         API versioning: url (default, /api/v1/), namespace or header (Accept: ...; version=v1)
+  --sample-rows int      Fake rows per crud/api model on top of its fixture (factory_boy + Faker)
+  --layout string        App layout: flat (default) or apps, which nests apps in apps/<name>
   -h, --help            Show this help message
 
 Examples:
   django-forge                           # Interactive mode
   django-forge -n myproject              # Set project name
   django-forge -n myproject -v 4.2.7     # Set name and Django version
+  django-forge -n myproject --app blog --app shop
+  django-forge -n myproject --app blog:crud --app books:api
+  django-forge -n myproject --app blog --layout apps  # Creates apps/blog
+  django-forge --auto -n myapi --api-only # JSON API with an api app
+  django-forge --auto -n myapi --api-only --openapi  # Plus Swagger UI and ReDoc
+  django-forge --auto -n myapi --api-only --api-auth jwt --api-permission IsAuthenticated
+  django-forge --auto -n myapi --api-only --api-framework ninja  # Django Ninja with docs at /api/v1/docs
+  django-forge --auto -n myproject --app books:crud --graphql  # Queries and mutations for Book
+  django-forge -n billing-portal         # Package name becomes billing_portal
   django-forge --auto -n myproject       # Non-interactive with defaults
+  django-forge -n myproject --superuser admin --superuser-email admin@example.com
   django-forge --install                 # Install globally on Windows
+  django-forge add-app blog              # Add an app to an existing project
+  django-forge add-app books:api         # Add an API app and route it in api.py
+  django-forge add api-version v2        # Serve /api/v2/ from <package>/api_v2.py
+  django-forge generate model shop Book title:char:200 author:fk:Author price:decimal --api
+  django-forge new .                     # Scaffold into the current directory
+  django-forge -n myproject --dir ~/code # Create ~/code/myproject
 
 Config file: ~/.django-forge.json (auto-created with your preferences)`)
 }
 
 func main() {
-	args := parseArgs()
+	if len(os.Args) > 1 && !strings.HasPrefix(os.Args[1], "-") {
+		if err := runCommand(os.Args[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
 
+	args := defineFlags(flag.CommandLine)
+	flag.Parse()
+	runCreate(*args)
+}
+
+// runCreate starts project creation, interactively or with --auto.
+func runCreate(args CLIArgs) {
 	if args.Help {
 		showHelp()
 		return
@@ -77,9 +189,102 @@ func main() {
 	if args.ProjectName != "" {
 		m.projectName = args.ProjectName
 	}
+	if args.PackageName != "" {
+		m.packageName = args.PackageName
+	}
 	if args.DjangoVersion != "" {
 		m.djangoVersion = args.DjangoVersion
 	}
+	if args.Layout != layoutFlat && args.Layout != layoutApps {
+		fmt.Fprintf(os.Stderr, "Error: unknown layout '%s' (choose flat or apps)\n", args.Layout)
+		os.Exit(1)
+	}
+	m.nestedApps = args.Layout == layoutApps
+	if err := validateAPIAuth(args.APIAuth, args.APIPermission); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := validateAPIFramework(args.APIFramework); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	m.apiFramework = args.APIFramework
+	if err := validateAPIVersioning(args.APIVersioning); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	m.apiVersioning = args.APIVersioning
+	if err := validateSampleRows(args.SampleRows); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	m.sampleRows = args.SampleRows
+	if m.sampleRows > 0 {
+		m.sampleRowsInput = fmt.Sprint(m.sampleRows)
+	}
+	m.apiAuth = args.APIAuth
+	m.apiPermission = args.APIPermission
+	m.parentDir = args.Dir
+	m.force = args.Force
+	m.inPlace = args.InPlace
+	if len(args.Apps) > 0 {
+		m.appNamesInput = strings.Join(args.Apps, ", ")
+		m.setApps(m.appNamesInput)
+	}
+	if args.APIOnly {
+		m.apiOnly = true
+		m.selectedOptions = append(m.selectedOptions, "API Only")
+		m.applyAPIOnly()
+	}
+	if args.OpenAPI {
+		m.setupOpenAPI = true
+		m.setupAPI = true
+		m.selectedOptions = append(m.selectedOptions, "OpenAPI Docs")
+	}
+	if args.APIExtras {
+		m.apiExtras = true
+		m.setupAPI = true
+		m.selectedOptions = append(m.selectedOptions, "API Extras")
+	}
+	if args.CORS {
+		m.setupCORS = true
+		m.selectedOptions = append(m.selectedOptions, "CORS")
+	}
+	if args.GraphQL {
+		m.setupGraphQL = true
+		m.selectedOptions = append(m.selectedOptions, "GraphQL")
+	}
+	if args.SkipInteractive && m.setupAPI {
+		m.applyAPIFramework()
+	}
+
+	if args.SkipInteractive && args.ProjectName != "" {
+		if err := m.validateProjectDir(m.projectName); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		m.packageName = m.resolvePackageName()
+		if err := validatePackageName(m.packageName); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if err := validateAppNames(m.appNamesInput, m.packageName); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	if args.Superuser != "" {
+		m.superuserName = args.Superuser
+		m.superuserEmail = args.SuperuserEmail
+		m.superuserPassword = args.SuperuserPass
+		if m.superuserPassword == "" {
+			m.superuserPassword = os.Getenv("DJANGO_SUPERUSER_PASSWORD")
+		}
+		if m.superuserPassword == "" && args.SkipInteractive {
+			fmt.Fprintln(os.Stderr, "A superuser password is required: pass --superuser-password or set DJANGO_SUPERUSER_PASSWORD")
+			os.Exit(1)
+		}
+	}
 
 	if args.SkipInteractive && args.ProjectName != "" {
 		m.step = stepSetup
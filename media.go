@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+func (m *Model) configureMediaFiles(projectPath, settingsPath string) error {
+	if !m.setupMedia {
+		return nil
+	}
+
+	m.updateProgress("Configuring media files...")
+
+	settingsContentBytes, err := os.ReadFile(settingsPath)
+	if err != nil {
+		return fmt.Errorf("failed to read settings.py for media files: %v", err)
+	}
+	settingsStr := string(settingsContentBytes)
+	if !strings.Contains(settingsStr, "MEDIA_ROOT") {
+		settingsStr += "\n# User-uploaded files\nMEDIA_URL = 'media/'\nMEDIA_ROOT = BASE_DIR / 'media'\n"
+		if err := os.WriteFile(settingsPath, []byte(settingsStr), 0644); err != nil {
+			return fmt.Errorf("failed to write updated settings.py: %v", err)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Join(projectPath, "media"), 0755); err != nil {
+		return fmt.Errorf("failed to create media directory: %v", err)
+	}
+
+	if err := m.writeProjectUrls(projectPath); err != nil {
+		return err
+	}
+
+	m.stepMessages = append(m.stepMessages, "✅ Configured MEDIA_URL/MEDIA_ROOT and media serving in development.")
+	return nil
+}
+
+// installPillow installs the imaging library ImageField depends on. It is only
+// needed when a CRUD or API app model gets an image field.
+func (m *Model) installPillow(projectPath string) error {
+	cmd := exec.Command(getPythonPath(projectPath), "-m", "pip", "install", "Pillow")
+	cmd.Dir = projectPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to install Pillow: %v\nOutput: %s", err, string(output))
+	}
+	m.stepMessages = append(m.stepMessages, "✅ Pillow installed for image uploads.")
+	return nil
+}
@@ -25,16 +25,5 @@ func (m *Model) runDjangoMigrations(projectPath string) error {
 	}
 	m.stepMessages = append(m.stepMessages, "✅ Applied database migrations.")
 
-	// Create sample data if REST framework is enabled and we have an app
-	if m.setupRestFramework && m.appName != "" {
-		m.updateProgress("Creating sample data...")
-		cmd = exec.Command(pythonPath, "manage.py", "create_sample_data")
-		cmd.Dir = projectPath
-		if output, err := cmd.CombinedOutput(); err != nil {
-			return fmt.Errorf("failed to create sample data: %v\nOutput: %s", err, string(output))
-		}
-		m.stepMessages = append(m.stepMessages, "✅ Created sample data for the API.")
-	}
-
-	return nil
+	return m.loadSampleData(projectPath)
 }
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/progress"
@@ -11,32 +13,62 @@ import (
 )
 
 type Model struct {
-	step               step
-	projectName        string
-	djangoVersion      string
-	features           []string
-	spinner            spinner.Model
-	progress           progress.Model
-	progressStatus     string
-	error              error
-	done               bool
-	program            *tea.Program
-	mainForm           *huh.Form
-	devServerForm      *huh.Form
-	selectedOptions    []string
-	appName            string
-	createTemplates    bool
-	createAppTemplates bool
-	runServer          bool
-	initializeGit      bool
-	setupTailwind      bool
-	setupRestFramework bool
-	startDevServer     bool
-	stepMessages       []string
-	splashCountdown    int
-	width              int
-	totalSteps         int
-	completedSteps     int
+	step              step
+	projectName       string
+	packageName       string
+	parentDir         string
+	inPlace           bool
+	force             bool
+	djangoVersion     string
+	features          []string
+	spinner           spinner.Model
+	progress          progress.Model
+	progressStatus    string
+	error             error
+	done              bool
+	program           *tea.Program
+	mainForm          *huh.Form
+	devServerForm     *huh.Form
+	selectedOptions   []string
+	appNamesInput     string
+	appNames          []string
+	appBlueprints     map[string]appBlueprint
+	defaultBlueprint  appBlueprint
+	nestedApps        bool
+	apiOnly           bool
+	apiFramework      string
+	setupOpenAPI      bool
+	apiAuth           string
+	apiExtras         bool
+	setupCORS         bool
+	setupGraphQL      bool
+	apiPermission     string
+	apiVersioning     string
+	sampleRows        int
+	sampleRowsInput   string
+	createTemplates   bool
+	runServer         bool
+	initializeGit     bool
+	setupTailwind     bool
+	setupAPI          bool
+	setupCustomUser   bool
+	superuserName     string
+	superuserEmail    string
+	superuserPassword string
+	setupProduction   bool
+	deployIssues      []deployCheckIssue
+	deployCheckRan    bool
+	setupI18n         bool
+	defaultLanguage   string
+	extraLanguages    []string
+	timeZone          string
+	setupMedia        bool
+	startDevServer    bool
+	stepMessages      []string
+	splashCountdown   int
+	width             int
+	totalSteps        int
+	completedSteps    int
 }
 
 func (m *Model) calculateTotalSteps() int {
@@ -45,7 +77,8 @@ func (m *Model) calculateTotalSteps() int {
 	if m.createTemplates {
 		steps++
 	}
-	if m.appName != "" {
+	steps += len(m.appNames)
+	if m.setupCustomUser {
 		steps++
 	}
 	if m.initializeGit {
@@ -54,14 +87,47 @@ func (m *Model) calculateTotalSteps() int {
 	if m.setupTailwind {
 		steps++
 	}
-	if m.setupRestFramework {
+	if m.setupAPI {
+		steps++
+	}
+	if m.setupOpenAPI {
+		steps += 2 // drf-spectacular setup and schema generation
+	}
+	if m.setupCORS {
+		steps++
+	}
+	if m.setupGraphQL {
 		steps++
 	}
 	steps++ // For migrations (makemigrations and migrate)
+	if len(m.sampleDataApps()) > 0 {
+		steps++ // Fixtures and fake rows of the crud and api apps
+	}
+	if m.superuserName != "" {
+		steps++
+	}
+	if m.setupProduction {
+		steps += 2 // Production settings and deployment check
+	}
+	if m.setupI18n {
+		steps += 2 // i18n settings and makemessages
+	}
+	if m.setupMedia {
+		steps++
+	}
 
 	return steps
 }
 
+// resolvePackageName returns the Python package name for the project: the
+// one entered by the user, or one derived from the directory name.
+func (m *Model) resolvePackageName() string {
+	if m.packageName != "" {
+		return m.packageName
+	}
+	return derivePackageName(m.projectName)
+}
+
 func (m *Model) updateProgress(status string) {
 	m.completedSteps++
 	progress := float64(m.completedSteps) / float64(m.totalSteps)
@@ -84,18 +150,23 @@ func NewModel() *Model {
 	)
 
 	m := &Model{
-		spinner:            s,
-		progress:           p,
-		step:               stepSplashScreen,
-		splashCountdown:    3,
-		features:           []string{"vanilla"},
-		createTemplates:    true,
-		createAppTemplates: true,
-		runServer:          false,
-		initializeGit:      true,
-		progressStatus:     "Initializing...",
-		selectedOptions:    []string{"Global Templates", "Initialize Git"},
-		completedSteps:     0,
+		spinner:          s,
+		progress:         p,
+		step:             stepSplashScreen,
+		splashCountdown:  3,
+		features:         []string{"vanilla"},
+		createTemplates:  true,
+		defaultBlueprint: blueprintPages,
+		apiFramework:     apiFrameworkDRF,
+		apiAuth:          apiAuthSession,
+		apiPermission:    "IsAuthenticatedOrReadOnly",
+		apiVersioning:    apiVersioningURL,
+		runServer:        false,
+		initializeGit:    true,
+		defaultLanguage:  "en",
+		progressStatus:   "Initializing...",
+		selectedOptions:  []string{"Global Templates", "Initialize Git"},
+		completedSteps:   0,
 	}
 
 	theme := huh.ThemeBase()
@@ -113,8 +184,30 @@ func NewModel() *Model {
 		huh.NewGroup(
 			huh.NewInput().
 				Title("Project Name").
+				DescriptionFunc(func() string {
+					if m.projectName == "" {
+						return "Directory to create; hyphens are turned into underscores for the package"
+					}
+					if err := m.validateProjectDir(m.projectName); err != nil {
+						return nameFeedback(err, "")
+					}
+					return nameFeedback(validatePackageName(m.resolvePackageName()), "package: "+m.resolvePackageName())
+				}, &m.projectName).
 				Value(&m.projectName).
-				Validate(validateProjectName),
+				Validate(m.validateProjectDir),
+			huh.NewInput().
+				Title("Package Name (Optional)").
+				Description("Python package for settings and URLs (leave empty to derive it from the project name)").
+				PlaceholderFunc(func() string {
+					return derivePackageName(m.projectName)
+				}, &m.projectName).
+				Value(&m.packageName).
+				Validate(func(name string) error {
+					if name == "" {
+						name = derivePackageName(m.projectName)
+					}
+					return validatePackageName(name)
+				}),
 		),
 		huh.NewGroup(
 			huh.NewInput().
@@ -126,24 +219,125 @@ func NewModel() *Model {
 		),
 		huh.NewGroup(
 			huh.NewInput().
-				Title("App Name (Optional)").
-				Description("Enter initial Django app name (leave empty to skip)").
-				Value(&m.appName),
+				Title("App Names (Optional)").
+				DescriptionFunc(func() string {
+					if strings.TrimSpace(m.appNamesInput) == "" {
+						return "Comma-separated list of Django apps to create; add :crud, :api, :pages or :empty to pick a blueprint"
+					}
+					return nameFeedback(validateAppNames(m.appNamesInput, m.resolvePackageName()), "valid app names")
+				}, &m.appNamesInput).
+				Placeholder("blog, shop:crud").
+				Value(&m.appNamesInput).
+				Validate(func(input string) error {
+					return validateAppNames(input, m.resolvePackageName())
+				}),
 		),
+		huh.NewGroup(
+			huh.NewSelect[appBlueprint]().
+				Title("App Blueprint").
+				Description("What to generate in apps listed without a blueprint").
+				Options(blueprintOptions()...).
+				Value(&m.defaultBlueprint),
+			huh.NewConfirm().
+				Title("Nest apps under apps/?").
+				Description("Create apps in apps/<name> and import them as apps.<name>").
+				Affirmative("Yes").
+				Negative("No").
+				Value(&m.nestedApps),
+			huh.NewInput().
+				Title("Fake Rows per Model").
+				Description("crud and api apps get a fixture with 3 objects; enter a number to also generate fake rows with Faker (empty for none)").
+				Placeholder("0").
+				Value(&m.sampleRowsInput).
+				Validate(func(input string) error {
+					_, err := parseSampleRows(input)
+					return err
+				}),
+		).WithHideFunc(func() bool {
+			return strings.TrimSpace(m.appNamesInput) == ""
+		}),
 		huh.NewGroup(
 			huh.NewMultiSelect[string]().
 				Title("Project Configuration").
 				Description("Select the features you want to include in your Django project").
 				Options(
 					huh.NewOption("Global Templates & Static Directories", "Global Templates").Selected(true),
-					huh.NewOption("App Templates (if creating an app)", "App Templates").Selected(true),
 					huh.NewOption("Initialize Git Repository", "Initialize Git").Selected(true),
 					huh.NewOption("Vanilla + Tailwind CSS v4", "Tailwind"),
-					huh.NewOption("Django REST Framework API", "REST Framework"),
+					huh.NewOption("REST API (Django REST Framework or Django Ninja)", "REST API"),
+					huh.NewOption("Custom User Model (accounts app)", "Custom User"),
+					huh.NewOption("Production Ready (security settings + deploy check)", "Production"),
+					huh.NewOption("Internationalization (i18n)", "Internationalization"),
+					huh.NewOption("Media File Uploads (MEDIA_URL/MEDIA_ROOT)", "Media"),
+					huh.NewOption("API Only (JSON REST API, no HTML templates)", "API Only"),
+					huh.NewOption("OpenAPI Docs (drf-spectacular schema, Swagger UI and ReDoc)", "OpenAPI Docs"),
+					huh.NewOption("API Extras (django-filter, search, ordering and throttling)", "API Extras"),
+					huh.NewOption("CORS for Separate Frontends (django-cors-headers)", "CORS"),
+					huh.NewOption("GraphQL Endpoint (Strawberry Django at /graphql/)", "GraphQL"),
 				).
-				Limit(5).
+				Limit(13).
 				Value(&m.selectedOptions),
 		),
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("API Framework").
+				Description("The library that serves the REST API").
+				Options(apiFrameworkOptions()...).
+				Value(&m.apiFramework),
+		).WithHideFunc(func() bool {
+			return !m.wantsAPI()
+		}),
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("API Authentication").
+				Description("How clients authenticate against the REST API").
+				Options(apiAuthOptions()...).
+				Value(&m.apiAuth),
+			huh.NewSelect[string]().
+				Title("Default API Permission").
+				Options(apiPermissionOptions()...).
+				Value(&m.apiPermission),
+			huh.NewSelect[string]().
+				Title("API Versioning").
+				Description("Where clients put the API version; add versions later with 'add api-version'").
+				Options(apiVersioningOptions()...).
+				Value(&m.apiVersioning),
+		).WithHideFunc(func() bool {
+			return !m.wantsAPI() || m.apiFramework != apiFrameworkDRF
+		}),
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Default Language").
+				Options(languageOptions()...).
+				Value(&m.defaultLanguage),
+			huh.NewMultiSelect[string]().
+				Title("Additional Languages").
+				Description("Locale directories and message files are created for each").
+				Options(languageOptions()...).
+				Value(&m.extraLanguages),
+		).WithHideFunc(func() bool {
+			return !contains(m.selectedOptions, "Internationalization")
+		}),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Admin Username (Optional)").
+				Description("Create a superuser after migrations (leave empty to skip)").
+				Value(&m.superuserName),
+			huh.NewInput().
+				Title("Admin Email").
+				Value(&m.superuserEmail).
+				Validate(validateSuperuserEmail),
+			huh.NewInput().
+				Title("Admin Password").
+				EchoMode(huh.EchoModePassword).
+				Value(&m.superuserPassword).
+				Validate(func(password string) error {
+					if m.superuserName != "" && password == "" {
+						return fmt.Errorf("password is required when creating a superuser")
+					}
+					return nil
+				}),
+		),
 	).WithTheme(theme)
 
 	m.devServerForm = huh.NewForm(
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// pythonKeywords is the full keyword list of Python 3. Soft keywords such as
+// match and case are valid identifiers and are not included.
+var pythonKeywords = []string{
+	"False", "None", "True", "and", "as", "assert", "async", "await", "break",
+	"class", "continue", "def", "del", "elif", "else", "except", "finally",
+	"for", "from", "global", "if", "import", "in", "is", "lambda", "nonlocal",
+	"not", "or", "pass", "raise", "return", "try", "while", "with", "yield",
+}
+
+// stdlibModules lists the top-level modules of the Python standard library.
+// A project or app with one of these names shadows the module on import.
+var stdlibModules = []string{
+	"abc", "aifc", "argparse", "array", "ast", "asynchat", "asyncio", "asyncore",
+	"atexit", "audioop", "base64", "bdb", "binascii", "bisect", "builtins", "bz2",
+	"cProfile", "calendar", "cgi", "cgitb", "chunk", "cmath", "cmd", "code",
+	"codecs", "codeop", "collections", "colorsys", "compileall", "concurrent",
+	"configparser", "contextlib", "contextvars", "copy", "copyreg", "crypt",
+	"csv", "ctypes", "curses", "dataclasses", "datetime", "dbm", "decimal",
+	"difflib", "dis", "distutils", "doctest", "email", "encodings", "ensurepip",
+	"enum", "errno", "faulthandler", "fcntl", "filecmp", "fileinput", "fnmatch",
+	"fractions", "ftplib", "functools", "gc", "genericpath", "getopt", "getpass",
+	"gettext", "glob", "graphlib", "grp", "gzip", "hashlib", "heapq", "hmac",
+	"html", "http", "idlelib", "imaplib", "imghdr", "imp", "importlib", "inspect",
+	"io", "ipaddress", "itertools", "json", "keyword", "lib2to3", "linecache",
+	"locale", "logging", "lzma", "mailbox", "mailcap", "marshal", "math",
+	"mimetypes", "mmap", "modulefinder", "msilib", "msvcrt", "multiprocessing",
+	"netrc", "nis", "nntplib", "nt", "ntpath", "nturl2path", "numbers", "opcode",
+	"operator", "optparse", "os", "ossaudiodev", "pathlib", "pdb", "pickle",
+	"pickletools", "pipes", "pkgutil", "platform", "plistlib", "poplib", "posix",
+	"posixpath", "pprint", "profile", "pstats", "pty", "pwd", "py_compile",
+	"pyclbr", "pydoc", "pydoc_data", "pyexpat", "queue", "quopri", "random", "re",
+	"readline", "reprlib", "resource", "rlcompleter", "runpy", "sched", "secrets",
+	"select", "selectors", "shelve", "shlex", "shutil", "signal", "site", "smtpd",
+	"smtplib", "sndhdr", "socket", "socketserver", "spwd", "sqlite3",
+	"sre_compile", "sre_constants", "sre_parse", "ssl", "stat", "statistics",
+	"string", "stringprep", "struct", "subprocess", "sunau", "symtable", "sys",
+	"sysconfig", "syslog", "tabnanny", "tarfile", "telnetlib", "tempfile",
+	"termios", "textwrap", "threading", "time", "timeit", "tkinter", "token",
+	"tokenize", "tomllib", "trace", "traceback", "tracemalloc", "tty", "turtle",
+	"turtledemo", "types", "typing", "unicodedata", "unittest", "urllib", "uu",
+	"uuid", "venv", "warnings", "wave", "weakref", "webbrowser", "winreg",
+	"winsound", "wsgiref", "xdrlib", "xml", "xmlrpc", "zipapp", "zipfile",
+	"zipimport", "zlib", "zoneinfo",
+}
+
+// installedModules are packages installed into the generated virtual
+// environment, plus CPython's own "test" package which stdlib listings omit.
+var installedModules = []string{
+	"django", "rest_framework", "django_browser_reload", "pip", "setuptools",
+	"pkg_resources", "wheel", "sqlparse", "asgiref", "tzdata", "PIL", "test",
+	"ninja", "pydantic", "strawberry", "strawberry_django", "graphql",
+	"factory", "faker",
+}
+
+// validatePythonIdentifier checks that name can be used as a Python module
+// name. kind ("project", "app") is used in error messages.
+func validatePythonIdentifier(kind, name string) error {
+	if name == "" {
+		return fmt.Errorf("%s name cannot be empty", kind)
+	}
+	for i, r := range name {
+		switch {
+		case r == '_' || unicode.IsLetter(r):
+		case unicode.IsDigit(r) && i > 0:
+		case unicode.IsDigit(r):
+			return fmt.Errorf("%s name '%s' cannot start with a digit", kind, name)
+		case r == '-':
+			return fmt.Errorf("%s name '%s' cannot contain '-'; use '_' instead", kind, name)
+		default:
+			return fmt.Errorf("%s name '%s' cannot contain '%c'; use letters, digits and underscores", kind, name, r)
+		}
+	}
+	return nil
+}
+
+// validatePythonName checks that name can be written in Python source as a
+// class, function or attribute name: an identifier that is not a keyword.
+func validatePythonName(kind, name string) error {
+	if err := validatePythonIdentifier(kind, name); err != nil {
+		return err
+	}
+	for _, keyword := range pythonKeywords {
+		if name == keyword {
+			return fmt.Errorf("'%s' is a Python keyword and cannot be used as %s name", name, kind)
+		}
+	}
+	return nil
+}
+
+// validateModuleName applies the checks Django runs in startproject and
+// startapp: a valid identifier that is not a keyword and does not shadow an
+// existing module.
+func validateModuleName(kind, name string) error {
+	if err := validatePythonName(kind, name); err != nil {
+		return err
+	}
+	// Compare case-insensitively: macOS and Windows file systems would
+	// still resolve the import to the existing module.
+	for _, module := range stdlibModules {
+		if strings.EqualFold(name, module) {
+			return fmt.Errorf("'%s' conflicts with the Python standard library module '%s'", name, module)
+		}
+	}
+	for _, module := range installedModules {
+		if strings.EqualFold(name, module) {
+			return fmt.Errorf("'%s' conflicts with the installed module '%s'", name, module)
+		}
+	}
+	return nil
+}
+
+// derivePackageName turns a directory name such as "billing-portal" into the
+// package name used for the Django project module ("billing_portal").
+func derivePackageName(dirName string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '.' || r == ' ' {
+			return '_'
+		}
+		return r
+	}, dirName)
+}
+
+// titleWords turns a Python name such as "blog_posts" into the words of a
+// human-readable title ("Blog Posts"), capitalizing the first letter of each.
+func titleWords(name string) string {
+	words := strings.Fields(strings.ReplaceAll(name, "_", " "))
+	for i, word := range words {
+		r, size := utf8.DecodeRuneInString(word)
+		words[i] = string(unicode.ToUpper(r)) + word[size:]
+	}
+	return strings.Join(words, " ")
+}
+
+func validatePackageName(name string) error {
+	return validateModuleName("package", name)
+}
+
+func validateAppName(name, projectName string) error {
+	if err := validateModuleName("app", name); err != nil {
+		return err
+	}
+	if projectName != "" && strings.EqualFold(name, projectName) {
+		return fmt.Errorf("app name '%s' clashes with the project package name", name)
+	}
+	return nil
+}
+
+// validateAppNames validates the comma-separated app list from the form,
+// including any blueprint suffixes.
+func validateAppNames(input, projectName string) error {
+	var seen []string
+	for _, entry := range strings.Split(input, ",") {
+		spec, err := parseAppSpec(entry)
+		if err != nil {
+			return err
+		}
+		name := spec.name
+		if name == "" {
+			continue
+		}
+		if contains(seen, name) {
+			return fmt.Errorf("app '%s' is listed more than once", name)
+		}
+		seen = append(seen, name)
+		if err := validateAppName(name, projectName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nameFeedback renders a validation result as a field description, giving
+// live feedback while the user types.
+func nameFeedback(err error, hint string) string {
+	if err != nil {
+		return "✗ " + err.Error()
+	}
+	return "✓ " + hint
+}
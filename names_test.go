@@ -0,0 +1,19 @@
+package main
+
+import "testing"
+
+func TestTitleWords(t *testing.T) {
+	tests := map[string]string{
+		"blog":         "Blog",
+		"blog_posts":   "Blog Posts",
+		"_private_app": "Private App",
+		"élan":         "Élan",
+		"home page":    "Home Page",
+		"":             "",
+	}
+	for name, want := range tests {
+		if got := titleWords(name); got != want {
+			t.Errorf("titleWords(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// ninjaFramework serves the API with Django Ninja: schemas and a Router per
+// model, added to the NinjaAPI instance in api.py, which also serves the
+// OpenAPI docs.
+type ninjaFramework struct {
+	m *Model
+}
+
+func (f *ninjaFramework) setup(projectPath string) error {
+	m := f.m
+	m.updateProgress("Setting up Django Ninja...")
+
+	cmd := exec.Command(getPythonPath(projectPath), "-m", "pip", "install", "django-ninja")
+	cmd.Dir = projectPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to install django-ninja: %v\nOutput: %s", err, string(output))
+	}
+	m.stepMessages = append(m.stepMessages, "✅ Django Ninja installed.")
+
+	imports := []string{"from ninja import NinjaAPI"}
+	var routes []string
+	for _, appName := range m.appsWithBlueprint(blueprintAPI) {
+		importLine, registerLine := f.route(m.blueprintModel(appName), m.blueprintAPIPrefix(appName))
+		imports = append(imports, importLine)
+		routes = append(routes, registerLine)
+	}
+	apiContent := strings.Join(imports, "\n") + fmt.Sprintf("\n\napi = NinjaAPI(title='%s API', version='1.0.0')\n", m.projectName)
+	if len(routes) > 0 {
+		apiContent += strings.Join(routes, "\n") + "\n"
+	}
+
+	apiPath := filepath.Join(projectPath, m.packageName, "api.py")
+	if err := os.WriteFile(apiPath, []byte(apiContent), 0644); err != nil {
+		return fmt.Errorf("failed to create api.py: %v", err)
+	}
+	if err := m.writeProjectUrls(projectPath); err != nil {
+		return err
+	}
+	m.stepMessages = append(m.stepMessages, "✅ Serving the Ninja API at /api/v1/ with interactive docs at /api/v1/docs.")
+	return nil
+}
+
+func (f *ninjaFramework) projectURLs() (localImports, patterns []string) {
+	return []string{"from .api import api"}, []string{"path('api/v1/', api.urls)"}
+}
+
+// writeModelAPI adds the schemas and the router to the app. Adding the
+// router to the project's NinjaAPI is left to the caller.
+func (f *ninjaFramework) writeModelAPI(spec *modelSpec, projectPath string) error {
+	appPath := moduleDir(projectPath, spec.appModule())
+	if err := writePythonSource(filepath.Join(appPath, "schemas.py"), spec.ninjaSchemaSource()); err != nil {
+		return err
+	}
+	return writePythonSource(filepath.Join(appPath, "api.py"), spec.ninjaRouterSource())
+}
+
+func (f *ninjaFramework) modelAPIFiles(appPath string) []string {
+	return []string{filepath.Join(appPath, "schemas.py"), filepath.Join(appPath, "api.py")}
+}
+
+func (f *ninjaFramework) route(spec *modelSpec, prefix string) (importLine, registerLine string) {
+	importLine = fmt.Sprintf("from %s.api import %s", spec.appModule(), spec.ninjaRouter())
+	registerLine = fmt.Sprintf("api.add_router('/%s/', %s)", prefix, spec.ninjaRouter())
+	return importLine, registerLine
+}
+
+func (s *modelSpec) ninjaRouter() string {
+	return snakeCase(s.name) + "_router"
+}
+
+func (s *modelSpec) ninjaSchemaSource() pythonSource {
+	var fields []string
+	for _, field := range s.inputFields() {
+		fields = append(fields, "'"+field.name+"'")
+	}
+	return pythonSource{
+		imports: []string{"from ninja import ModelSchema", "from .models import " + s.name},
+		body: fmt.Sprintf(`class %[1]sIn(ModelSchema):
+    class Meta:
+        model = %[1]s
+        fields = [%[2]s]
+
+
+class %[1]sOut(ModelSchema):
+    class Meta:
+        model = %[1]s
+        fields = '__all__'
+`, s.name, strings.Join(fields, ", ")),
+	}
+}
+
+func (s *modelSpec) ninjaRouterSource() pythonSource {
+	single := snakeCase(s.name)
+	plural := pluralize(single)
+	queryset := s.name + ".objects.all()"
+	if s.timestamps {
+		queryset = s.name + ".objects.order_by('-created_at')"
+	}
+
+	// The schemas name relations after the field; the model takes their
+	// primary keys as <field>_id.
+	payload, prepare := "payload.dict()", ""
+	for _, field := range s.inputFields() {
+		if field.kind == "fk" || field.kind == "o2o" {
+			prepare += fmt.Sprintf("    data['%[1]s_id'] = data.pop('%[1]s')\n", field.name)
+		}
+	}
+	if prepare != "" {
+		payload, prepare = "data", "    data = payload.dict()\n"+prepare
+	}
+
+	imports := []string{"from typing import List", "from django.shortcuts import get_object_or_404"}
+	if s.timestamps {
+		imports = []string{
+			"from datetime import timedelta",
+			"from typing import List",
+			"from django.shortcuts import get_object_or_404",
+			"from django.utils import timezone",
+		}
+	}
+	imports = append(imports,
+		"from ninja import Router",
+		"from ninja.pagination import PageNumberPagination, paginate",
+		"from .models import "+s.name,
+		fmt.Sprintf("from .schemas import %[1]sIn, %[1]sOut", s.name),
+	)
+	var b strings.Builder
+	fmt.Fprintf(&b, `%[1]s = Router(tags=['%[2]s'])
+
+
+@%[1]s.get('/', response=List[%[3]sOut])
+@paginate(PageNumberPagination, page_size=20)
+def list_%[4]s(request):
+    return %[5]s
+`, s.ninjaRouter(), s.verbosePlural(), s.name, plural, queryset)
+
+	if s.timestamps {
+		fmt.Fprintf(&b, `
+
+@%[1]s.get('/recent/', response=List[%[2]sOut])
+def recent_%[3]s(request):
+    return %[2]s.objects.filter(created_at__gte=timezone.now() - timedelta(days=30))
+`, s.ninjaRouter(), s.name, plural)
+	}
+
+	fmt.Fprintf(&b, `
+
+@%[1]s.post('/', response={201: %[2]sOut})
+def create_%[3]s(request, payload: %[2]sIn):
+%[4]s    return 201, %[2]s.objects.create(**%[5]s)
+
+
+@%[1]s.get('/{%[3]s_id}/', response=%[2]sOut)
+def get_%[3]s(request, %[3]s_id: int):
+    return get_object_or_404(%[2]s, pk=%[3]s_id)
+
+
+@%[1]s.put('/{%[3]s_id}/', response=%[2]sOut)
+def update_%[3]s(request, %[3]s_id: int, payload: %[2]sIn):
+    %[3]s = get_object_or_404(%[2]s, pk=%[3]s_id)
+%[4]s    for attr, value in %[5]s.items():
+        setattr(%[3]s, attr, value)
+    %[3]s.save()
+    return %[3]s
+
+
+@%[1]s.delete('/{%[3]s_id}/', response={204: None})
+def delete_%[3]s(request, %[3]s_id: int):
+    get_object_or_404(%[2]s, pk=%[3]s_id).delete()
+    return 204, None
+`, s.ninjaRouter(), s.name, single, prepare, payload)
+
+	return pythonSource{imports: imports, body: b.String()}
+}
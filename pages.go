@@ -0,0 +1,211 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// vanillaPageCSS styles generated pages in projects without Tailwind.
+const vanillaPageCSS = `
+/* Generated pages */
+.page {
+    max-width: 48rem;
+    margin: 0 auto;
+    padding: 4rem 1.5rem;
+}
+
+.page h1 {
+    font-size: 2.25rem;
+    font-weight: 700;
+    margin-bottom: 1rem;
+}
+
+.page p {
+    color: #9ca3af;
+    line-height: 1.75;
+}
+`
+
+var navPattern = regexp.MustCompile(`(?s)<nav\b[^>]*>.*?</nav>`)
+var navLinkPattern = regexp.MustCompile(`(?m)^([ \t]*)<a href="[^"]*" class="([^"]*)">.*</a>[ \t]*$`)
+
+// usesTailwind reports whether the base template loads the compiled
+// Tailwind stylesheet rather than the vanilla style.css.
+func usesTailwind(projectPath string) bool {
+	baseContent, err := os.ReadFile(filepath.Join(projectPath, "templates", "base.html"))
+	return err == nil && strings.Contains(string(baseContent), "dist/styles.css")
+}
+
+func pageContent(projectPath, title string) string {
+	if usesTailwind(projectPath) {
+		return appPage(projectPath, title, fmt.Sprintf(`<section class="max-w-4xl mx-auto px-4 sm:px-6 lg:px-8 py-16">
+    <h1 class="text-4xl md:text-5xl font-bold text-white mb-6">%s</h1>
+    <p class="text-gray-400 text-lg leading-relaxed">Write the content of this page here.</p>
+</section>`, title))
+	}
+	return appPage(projectPath, title, fmt.Sprintf(`<section class="page">
+    <h1>%s</h1>
+    <p>Write the content of this page here.</p>
+</section>`, title))
+}
+
+// addNavLink inserts a link into the base template's <nav>, copying the
+// markup of its first plain link so it matches the existing styling. The
+// link goes after the last link styled the same way, ahead of call-to-action
+// buttons.
+func addNavLink(baseContent, href, label string) (string, error) {
+	navLoc := navPattern.FindStringIndex(baseContent)
+	if navLoc == nil {
+		return baseContent, fmt.Errorf("could not find a <nav> element in base.html")
+	}
+	nav := baseContent[navLoc[0]:navLoc[1]]
+	if strings.Contains(nav, `href="`+href+`"`) {
+		return baseContent, nil
+	}
+
+	links := navLinkPattern.FindAllStringSubmatchIndex(nav, -1)
+	if len(links) == 0 {
+		return baseContent, fmt.Errorf("could not find a link to copy in the base.html <nav>")
+	}
+	indent := nav[links[0][2]:links[0][3]]
+	class := nav[links[0][4]:links[0][5]]
+	insertAt := links[0][1]
+	for _, link := range links {
+		if nav[link[4]:link[5]] == class {
+			insertAt = link[1]
+		}
+	}
+
+	text := label
+	if strings.Contains(baseContent, "{% load i18n %}") {
+		text = fmt.Sprintf(`{%% translate "%s" %%}`, label)
+	}
+	newLink := fmt.Sprintf("\n%s<a href=\"%s\" class=\"%s\">%s</a>", indent, href, class, text)
+	nav = nav[:insertAt] + newLink + nav[insertAt:]
+	return baseContent[:navLoc[0]] + nav + baseContent[navLoc[1]:], nil
+}
+
+func runGeneratePage(args []string) error {
+	fs := flag.NewFlagSet("generate page", flag.ContinueOnError)
+	title := fs.String("title", "", "Page title and nav label (default: derived from the name)")
+	noNav := fs.Bool("no-nav", false, "Don't add a link to the base template's navigation")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return fmt.Errorf("usage: django-forge generate page <app> <name> [--title text] [--no-nav]")
+	}
+	appName, pageName := positional[0], positional[1]
+	if err := validatePythonName("page", pageName); err != nil {
+		return err
+	}
+	if *title == "" {
+		*title = titleWords(pageName)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %v", err)
+	}
+	project, err := findProject(wd)
+	if err != nil {
+		return err
+	}
+	m := project.model()
+	appPath := m.appDir(project.root, appName)
+	viewsContent, err := os.ReadFile(filepath.Join(appPath, "views.py"))
+	if err != nil {
+		return fmt.Errorf("app '%s' not found in %s (create it with add-app first)", appName, project.root)
+	}
+	if regexp.MustCompile(`(?m)^(def|class) ` + pageName + `\b`).Match(viewsContent) {
+		return fmt.Errorf("%s already defines '%s'", filepath.Join(m.appDir("", appName), "views.py"), pageName)
+	}
+
+	var messages []string
+	templateName := fmt.Sprintf("%s/%s.html", appName, pageName)
+	if err := writeAppFile(appPath, pageContent(project.root, *title), "templates", appName, pageName+".html"); err != nil {
+		return err
+	}
+	if !usesTailwind(project.root) && project.hasGlobalTemplates() {
+		if err := addVanillaPageCSS(project.root); err != nil {
+			return err
+		}
+	}
+
+	view := pythonSource{
+		imports: []string{"from django.shortcuts import render"},
+		body:    fmt.Sprintf("def %s(request):\n    return render(request, '%s')\n", pageName, templateName),
+	}
+	if err := writePythonSource(filepath.Join(appPath, "views.py"), view); err != nil {
+		return err
+	}
+
+	urlPath := strings.ReplaceAll(pageName, "_", "-") + "/"
+	if pageName == "index" {
+		urlPath = ""
+	}
+	pattern := fmt.Sprintf("path('%s', views.%s, name='%s')", urlPath, pageName, pageName)
+	urlsPath := filepath.Join(appPath, "urls.py")
+	urlsContent, err := os.ReadFile(urlsPath)
+	if os.IsNotExist(err) {
+		content := fmt.Sprintf("from django.urls import path\n\nfrom . import views\n\napp_name = '%s'\nurlpatterns = [\n    %s,\n]\n", appName, pattern)
+		if err := os.WriteFile(urlsPath, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to create urls.py for app %s: %v", appName, err)
+		}
+		if err := mountApp(project, appName); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to read urls.py for app %s: %v", appName, err)
+	} else {
+		content, err := addURLPattern(addPythonImport(string(urlsContent), "from . import views"), pattern)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(urlsPath, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to update urls.py for app %s: %v", appName, err)
+		}
+	}
+	messages = append(messages, fmt.Sprintf("✅ Added the %s page: view %s.views.%s, template %s, URL name '%s:%s'.", *title, m.appModule(appName), pageName, templateName, appName, pageName))
+
+	if !*noNav && project.hasGlobalTemplates() {
+		basePath := filepath.Join(project.root, "templates", "base.html")
+		baseContent, err := os.ReadFile(basePath)
+		if err != nil {
+			return fmt.Errorf("failed to read base.html: %v", err)
+		}
+		href := fmt.Sprintf("{%% url '%s:%s' %%}", appName, pageName)
+		updatedBase, err := addNavLink(string(baseContent), href, *title)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("⚠️  Warning: %v; add a link to %s by hand.", err, href))
+		} else if err := os.WriteFile(basePath, []byte(updatedBase), 0644); err != nil {
+			return fmt.Errorf("failed to update base.html: %v", err)
+		} else {
+			messages = append(messages, "✅ Linked the page from the navigation in base.html.")
+		}
+	}
+
+	printStepMessages(messages)
+	return nil
+}
+
+// addVanillaPageCSS appends the generated page styles to style.css once.
+func addVanillaPageCSS(projectPath string) error {
+	cssPath := filepath.Join(projectPath, "static", "css", "style.css")
+	cssContent, err := os.ReadFile(cssPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read style.css: %v", err)
+	}
+	if strings.Contains(string(cssContent), "/* Generated pages */") {
+		return nil
+	}
+	if err := os.WriteFile(cssPath, append(cssContent, []byte(vanillaPageCSS)...), 0644); err != nil {
+		return fmt.Errorf("failed to update style.css: %v", err)
+	}
+	return nil
+}
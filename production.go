@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+type deployCheckIssue struct {
+	level   string
+	id      string
+	message string
+	hint    string
+}
+
+var deployCheckLine = regexp.MustCompile(`^(\S.*?): \(([\w.]+)\) (.*)$`)
+
+func (m *Model) configureProductionSettings(projectPath string) error {
+	if !m.setupProduction {
+		return nil
+	}
+
+	m.updateProgress("Configuring production settings...")
+
+	productionSettingsContent := fmt.Sprintf(`"""
+Production settings.
+
+Deploy with DJANGO_SETTINGS_MODULE=%[1]s.settings_production and provide the
+values below through the environment.
+"""
+import os
+
+from .settings import *  # noqa: F401,F403
+
+DEBUG = False
+
+SECRET_KEY = os.environ.get('DJANGO_SECRET_KEY', SECRET_KEY)
+ALLOWED_HOSTS = [host for host in os.environ.get('DJANGO_ALLOWED_HOSTS', '').split(',') if host]
+CSRF_TRUSTED_ORIGINS = [
+    origin for origin in os.environ.get('DJANGO_CSRF_TRUSTED_ORIGINS', '').split(',') if origin
+]
+
+# HTTPS
+SECURE_SSL_REDIRECT = True
+SECURE_HSTS_SECONDS = 31536000
+SECURE_HSTS_INCLUDE_SUBDOMAINS = True
+SECURE_HSTS_PRELOAD = True
+# Uncomment when running behind a proxy that terminates TLS.
+# SECURE_PROXY_SSL_HEADER = ('HTTP_X_FORWARDED_PROTO', 'https')
+
+# Cookies
+SESSION_COOKIE_SECURE = True
+CSRF_COOKIE_SECURE = True
+
+# Headers
+SECURE_CONTENT_TYPE_NOSNIFF = True
+SECURE_REFERRER_POLICY = 'same-origin'
+X_FRAME_OPTIONS = 'DENY'
+`, m.packageName)
+	if m.setupCORS {
+		// No local dev server origins in production.
+		productionSettingsContent += `
+# CORS
+CORS_ALLOWED_ORIGINS = [
+    origin for origin in os.environ.get('CORS_ALLOWED_ORIGINS', '').split(',') if origin
+]
+`
+	}
+
+	productionSettingsPath := filepath.Join(projectPath, m.packageName, "settings_production.py")
+	if err := os.WriteFile(productionSettingsPath, []byte(productionSettingsContent), 0644); err != nil {
+		return fmt.Errorf("failed to create settings_production.py: %v", err)
+	}
+	m.stepMessages = append(m.stepMessages, "✅ Created settings_production.py with security settings.")
+	return nil
+}
+
+func (m *Model) runDeployCheck(projectPath string) error {
+	if !m.setupProduction {
+		return nil
+	}
+
+	m.updateProgress("Running deployment checks...")
+	pythonPath := getPythonPath(projectPath)
+
+	cmd := exec.Command(pythonPath, "manage.py", "check", "--deploy", "--settings="+m.packageName+".settings_production")
+	cmd.Dir = projectPath
+	output, err := cmd.CombinedOutput()
+	m.deployIssues = parseDeployCheckOutput(string(output))
+	// check exits non-zero when it reports errors; only fail if there was
+	// nothing to report, which means the command itself broke.
+	if err != nil && len(m.deployIssues) == 0 {
+		return fmt.Errorf("failed to run deployment checks: %v\nOutput: %s", err, string(output))
+	}
+	m.deployCheckRan = true
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Deployment check finished with %d issue(s).", len(m.deployIssues)))
+	return nil
+}
+
+func parseDeployCheckOutput(output string) []deployCheckIssue {
+	var issues []deployCheckIssue
+	level := "WARNING"
+	for _, line := range strings.Split(output, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch trimmed {
+		case "CRITICALS:", "ERRORS:", "WARNINGS:", "INFOS:", "DEBUGS:":
+			level = strings.TrimSuffix(strings.TrimSuffix(trimmed, ":"), "S")
+			continue
+		}
+		if strings.HasPrefix(trimmed, "HINT:") && len(issues) > 0 {
+			issues[len(issues)-1].hint = strings.TrimSpace(strings.TrimPrefix(trimmed, "HINT:"))
+			continue
+		}
+		if match := deployCheckLine.FindStringSubmatch(line); match != nil {
+			issues = append(issues, deployCheckIssue{
+				level:   level,
+				id:      match[2],
+				message: match[3],
+			})
+		}
+	}
+	return issues
+}
@@ -18,18 +18,22 @@ func (m *Model) CreateProject() {
 		currentErr = fmt.Errorf("project name cannot be empty")
 		return
 	}
+	m.packageName = m.resolvePackageName()
+	if m.setupCustomUser && contains(m.appNames, customUserApp) {
+		currentErr = fmt.Errorf("app name '%s' is reserved for the custom user model", customUserApp)
+		return
+	}
 
 	m.totalSteps = m.calculateTotalSteps()
 	m.completedSteps = 0
 
-	projectPath := m.projectName
-	if !filepath.IsAbs(projectPath) {
-		wd, err := os.Getwd()
-		if err != nil {
-			currentErr = fmt.Errorf("failed to get working directory: %v", err)
-			return
-		}
-		projectPath = filepath.Join(wd, m.projectName)
+	projectPath, err := m.projectDir()
+	if err != nil {
+		currentErr = err
+		return
+	}
+	if currentErr = m.checkProjectDir(projectPath); currentErr != nil {
+		return
 	}
 
 	if err := os.MkdirAll(projectPath, 0755); err != nil {
@@ -51,7 +55,7 @@ func (m *Model) CreateProject() {
 		return
 	}
 
-	settingsPath := filepath.Join(projectPath, m.projectName, "settings.py")
+	settingsPath := filepath.Join(projectPath, m.packageName, "settings.py")
 	if currentErr = m.configureDjangoSettings(settingsPath); currentErr != nil {
 		return
 	}
@@ -77,13 +81,32 @@ func (m *Model) CreateProject() {
 		m.stepMessages = append(m.stepMessages, "✅ Configured settings for global templates and static files.")
 	}
 
+	if currentErr = m.configureI18n(projectPath, settingsPath); currentErr != nil {
+		return
+	}
+
+	if currentErr = m.configureMediaFiles(projectPath, settingsPath); currentErr != nil {
+		return
+	}
+
 	m.updateProgress("Finalizing settings configuration...")
 
-	if m.appName != "" {
-		if currentErr = m.createDjangoApp(projectPath, settingsPath); currentErr != nil {
+	if currentErr = m.createAccountsApp(projectPath, settingsPath); currentErr != nil {
+		return
+	}
+
+	for _, appName := range m.appNames {
+		if currentErr = m.createDjangoApp(projectPath, settingsPath, appName); currentErr != nil {
 			return
 		}
-		m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Created Django app '%s' with templates and URLs.", m.appName))
+	}
+	if m.setupMedia && len(m.appsWithBlueprint(blueprintCRUD, blueprintAPI)) > 0 {
+		if currentErr = m.installPillow(projectPath); currentErr != nil {
+			return
+		}
+	}
+	if currentErr = m.writeProjectUrls(projectPath); currentErr != nil {
+		return
 	}
 
 	if m.initializeGit {
@@ -99,16 +122,44 @@ func (m *Model) CreateProject() {
 		}
 	}
 
-	if m.setupRestFramework {
-		if currentErr = m.setupDjangoRestFramework(projectPath); currentErr != nil {
+	if m.setupAPI {
+		if currentErr = m.api().setup(projectPath); currentErr != nil {
 			return
 		}
 	}
 
+	if currentErr = m.setupOpenAPISchema(projectPath, settingsPath); currentErr != nil {
+		return
+	}
+
+	if currentErr = m.configureCORS(projectPath, settingsPath); currentErr != nil {
+		return
+	}
+
+	if currentErr = m.setupGraphQLEndpoint(projectPath, settingsPath); currentErr != nil {
+		return
+	}
+
+	if currentErr = m.runMakeMessages(projectPath); currentErr != nil {
+		return
+	}
+
 	if currentErr = m.runDjangoMigrations(projectPath); currentErr != nil {
 		return
 	}
 
+	if currentErr = m.createSuperuser(projectPath); currentErr != nil {
+		return
+	}
+
+	if currentErr = m.configureProductionSettings(projectPath); currentErr != nil {
+		return
+	}
+
+	if currentErr = m.runDeployCheck(projectPath); currentErr != nil {
+		return
+	}
+
 	if m.runServer {
 		m.setupServerInstructions(projectPath)
 	}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// pythonSource is generated Python code together with the imports it needs,
+// so it can be written to a new module or merged into an existing one.
+type pythonSource struct {
+	imports []string
+	body    string
+}
+
+// String renders the source as a complete module: imports first, with local
+// (relative) imports in their own group, then the body.
+func (src pythonSource) String() string {
+	var imports, localImports []string
+	for _, imp := range src.imports {
+		if strings.HasPrefix(imp, "from .") {
+			localImports = append(localImports, imp)
+		} else {
+			imports = append(imports, imp)
+		}
+	}
+
+	var groups []string
+	if len(imports) > 0 {
+		groups = append(groups, strings.Join(imports, "\n"))
+	}
+	if len(localImports) > 0 {
+		groups = append(groups, strings.Join(localImports, "\n"))
+	}
+	header := strings.Join(groups, "\n\n")
+	if header == "" {
+		return src.body
+	}
+	return header + "\n\n\n" + src.body
+}
+
+// startappImports are the imports startapp puts in its placeholder modules.
+var startappImports = []string{
+	"from django.db import models",
+	"from django.contrib import admin",
+	"from django.shortcuts import render",
+	"from django.test import TestCase",
+}
+
+// isStartappPlaceholder reports whether content is still the module startapp
+// wrote: nothing but its default import and "Create your ... here" comment.
+func isStartappPlaceholder(content string) bool {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || contains(startappImports, line) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// mergePythonSource adds src to an existing module: missing imports are added
+// next to the existing ones and the body is appended. Placeholder modules from
+// startapp are replaced instead.
+func mergePythonSource(existing string, src pythonSource) string {
+	if isStartappPlaceholder(existing) {
+		return src.String()
+	}
+	content := existing
+	for _, imp := range src.imports {
+		content = addPythonImport(content, imp)
+	}
+	return strings.TrimRight(content, "\n") + "\n\n\n" + src.body
+}
+
+// writePythonSource merges src into the module at path, creating it if needed.
+func writePythonSource(path string, src pythonSource) error {
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read %s: %v", path, err)
+	}
+	if err := os.WriteFile(path, []byte(mergePythonSource(string(existing), src)), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %v", path, err)
+	}
+	return nil
+}
+
+// addPythonImport adds an import line unless it is already there. A
+// "from x import a" line is merged into an existing single-line import from
+// the same module; anything else goes after the last top-level import of the
+// same kind, keeping relative imports in their own group at the end.
+func addPythonImport(content, importLine string) string {
+	lines := strings.Split(content, "\n")
+	module, names, isFrom := splitFromImport(importLine)
+	isLocal := strings.HasPrefix(importLine, "from .")
+
+	lastImport, lastOfKind := -1, -1
+	for i, line := range lines {
+		if line == importLine {
+			return content
+		}
+		if !strings.HasPrefix(line, "import ") && !strings.HasPrefix(line, "from ") {
+			continue
+		}
+		// A parenthesized import ends at its closing parenthesis.
+		end := i
+		if strings.Contains(line, "(") {
+			for end < len(lines)-1 && !strings.Contains(lines[end], ")") {
+				end++
+			}
+		}
+		lastImport = end
+		if strings.HasPrefix(line, "from .") == isLocal {
+			lastOfKind = end
+		}
+		if !isFrom || strings.Contains(line, "(") {
+			continue
+		}
+		if existingModule, existingNames, ok := splitFromImport(line); ok && existingModule == module {
+			for _, name := range names {
+				if !contains(existingNames, name) {
+					existingNames = append(existingNames, name)
+				}
+			}
+			sort.Strings(existingNames)
+			lines[i] = "from " + module + " import " + strings.Join(existingNames, ", ")
+			return strings.Join(lines, "\n")
+		}
+	}
+
+	switch {
+	case lastImport == -1:
+		return importLine + "\n\n" + content
+	case lastOfKind != -1:
+		lastImport = lastOfKind
+	case isLocal:
+		// First relative import: start its group after the others.
+		importLine = "\n" + importLine
+	default:
+		// First absolute import: put it before the relative group.
+		for i, line := range lines {
+			if strings.HasPrefix(line, "from .") {
+				lines = append(lines[:i], append([]string{importLine, ""}, lines[i:]...)...)
+				return strings.Join(lines, "\n")
+			}
+		}
+	}
+	lines = append(lines[:lastImport+1], append([]string{importLine}, lines[lastImport+1:]...)...)
+	return strings.Join(lines, "\n")
+}
+
+func splitFromImport(line string) (module string, names []string, ok bool) {
+	if !strings.HasPrefix(line, "from ") {
+		return "", nil, false
+	}
+	module, imported, found := strings.Cut(strings.TrimPrefix(line, "from "), " import ")
+	if !found {
+		return "", nil, false
+	}
+	for _, name := range strings.Split(imported, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return strings.TrimSpace(module), names, true
+}
@@ -0,0 +1,112 @@
+package main
+
+import "testing"
+
+func TestAddPythonImport(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		importLine string
+		want       string
+	}{
+		{
+			name:       "no imports",
+			content:    "x = 1\n",
+			importLine: "import os",
+			want:       "import os\n\nx = 1\n",
+		},
+		{
+			name:       "merge into the same module",
+			content:    "from django.db import models\n\n\nclass A(models.Model):\n    pass\n",
+			importLine: "from django.db import connection",
+			want:       "from django.db import connection, models\n\n\nclass A(models.Model):\n    pass\n",
+		},
+		{
+			name:       "after the last absolute import",
+			content:    "from django.contrib import admin\n\nfrom .models import Post\n",
+			importLine: "from django.urls import path",
+			want:       "from django.contrib import admin\nfrom django.urls import path\n\nfrom .models import Post\n",
+		},
+		{
+			name:       "after the last relative import",
+			content:    "from django.contrib import admin\n\nfrom .models import Post\n\nadmin.site.register(Post)\n",
+			importLine: "from .forms import PostForm",
+			want:       "from django.contrib import admin\n\nfrom .models import Post\nfrom .forms import PostForm\n\nadmin.site.register(Post)\n",
+		},
+		{
+			name:       "first relative import",
+			content:    "from django.contrib import admin\n\nadmin.site.register(Post)\n",
+			importLine: "from .models import Post",
+			want:       "from django.contrib import admin\n\nfrom .models import Post\n\nadmin.site.register(Post)\n",
+		},
+		{
+			name:       "first absolute import before relative ones",
+			content:    "from .models import Post\n\nx = Post\n",
+			importLine: "from django.contrib import admin",
+			want:       "from django.contrib import admin\n\nfrom .models import Post\n\nx = Post\n",
+		},
+		{
+			name:       "parenthesized import is left alone",
+			content:    "from django.db import (\n    models,\n)\n",
+			importLine: "from django.db import connection",
+			want:       "from django.db import (\n    models,\n)\nfrom django.db import connection\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := addPythonImport(tt.content, tt.importLine)
+			if got != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+			if again := addPythonImport(got, tt.importLine); again != got {
+				t.Errorf("adding the import twice changed the module:\n%s", again)
+			}
+		})
+	}
+}
+
+func TestMergePythonSource(t *testing.T) {
+	src := pythonSource{
+		imports: []string{"from django.db import models"},
+		body:    "class Post(models.Model):\n    pass\n",
+	}
+	tests := []struct {
+		name     string
+		existing string
+		want     string
+	}{
+		{
+			name:     "new module",
+			existing: "",
+			want:     "from django.db import models\n\n\nclass Post(models.Model):\n    pass\n",
+		},
+		{
+			name:     "startapp placeholder",
+			existing: "from django.db import models\n\n# Create your models here.\n",
+			want:     "from django.db import models\n\n\nclass Post(models.Model):\n    pass\n",
+		},
+		{
+			name:     "existing models",
+			existing: "from django.conf import settings\n\n\nclass Tag(models.Model):\n    pass\n",
+			want:     "from django.conf import settings\nfrom django.db import models\n\n\nclass Tag(models.Model):\n    pass\n\n\nclass Post(models.Model):\n    pass\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergePythonSource(tt.existing, src); got != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPythonSourceString(t *testing.T) {
+	src := pythonSource{
+		imports: []string{"from django.contrib import admin", "from .models import Post"},
+		body:    "admin.site.register(Post)\n",
+	}
+	want := "from django.contrib import admin\n\nfrom .models import Post\n\n\nadmin.site.register(Post)\n"
+	if got := src.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
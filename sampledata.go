@@ -0,0 +1,362 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// fixtureRows is the number of objects in each app's fixture.
+const fixtureRows = 3
+
+// maxSampleRows caps the fake rows generated per model.
+const maxSampleRows = 10000
+
+// sampleDataApps returns the apps whose blueprint generates a model, which
+// get a fixture and, with sample rows, a factory.
+func (m *Model) sampleDataApps() []string {
+	return m.appsWithBlueprint(blueprintCRUD, blueprintAPI)
+}
+
+// parseSampleRows reads the number of fake rows per model from the form or
+// the --sample-rows flag.
+func parseSampleRows(input string) (int, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, nil
+	}
+	rows, err := strconv.Atoi(input)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a number", input)
+	}
+	return rows, validateSampleRows(rows)
+}
+
+func validateSampleRows(rows int) error {
+	if rows < 0 || rows > maxSampleRows {
+		return fmt.Errorf("sample rows must be between 0 and %d, got %d", maxSampleRows, rows)
+	}
+	return nil
+}
+
+// fixturePath is the app's fixture, named after the app so loaddata finds it
+// by that name.
+func fixturePath(appPath, appName string) string {
+	return filepath.Join(appPath, "fixtures", appName+".json")
+}
+
+// writeSampleData adds the model's objects to the app's fixture and, when
+// sample rows were asked for or the app already has factories, its factory.
+// Models with required fields a fixture or factory can't fill are skipped
+// with a warning.
+func (m *Model) writeSampleData(spec *modelSpec, projectPath string) error {
+	if unfillable := spec.unfillableFields(); len(unfillable) > 0 {
+		m.stepMessages = append(m.stepMessages, fmt.Sprintf("⚠️  Warning: skipped the sample data of %s, as fixtures and factories can't fill its required field(s) %s.", spec.name, strings.Join(unfillable, ", ")))
+		return nil
+	}
+
+	appPath := m.appDir(projectPath, spec.app)
+	if err := spec.writeFixture(fixturePath(appPath, spec.app)); err != nil {
+		return err
+	}
+
+	factoriesPath := filepath.Join(appPath, "factories.py")
+	if _, err := os.Stat(factoriesPath); m.sampleRows == 0 && err != nil {
+		return nil
+	}
+	if err := writePythonSource(factoriesPath, spec.factorySource()); err != nil {
+		return err
+	}
+	if m.sampleRows == 0 {
+		return nil
+	}
+	files := map[string]string{
+		filepath.Join("management", "__init__.py"):             "",
+		filepath.Join("management", "commands", "__init__.py"): "",
+		filepath.Join("management", "commands", sampleDataCommand(spec.app)+".py"): fmt.Sprintf(`from django.core.management.base import BaseCommand
+from factory.django import DjangoModelFactory
+
+from %[1]s import factories
+
+
+class Command(BaseCommand):
+    help = 'Creates objects filled with fake data with every factory in %[1]s.factories'
+
+    def add_arguments(self, parser):
+        parser.add_argument('--count', type=int, default=%[2]d, help='Number of objects to create per model')
+
+    def handle(self, *args, **options):
+        for factory_class in vars(factories).values():
+            if (
+                isinstance(factory_class, type)
+                and issubclass(factory_class, DjangoModelFactory)
+                and factory_class.__module__ == factories.__name__
+            ):
+                factory_class.create_batch(options['count'])
+                self.stdout.write(f"Created {options['count']} {factory_class._meta.model.__name__} objects.")
+
+        self.stdout.write(self.style.SUCCESS('Sample data created successfully!'))
+`, spec.appModule(), m.sampleRows),
+	}
+	for name, content := range files {
+		if err := writeAppFile(appPath, content, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sampleDataCommand names the management command that fills an app with fake
+// rows. It includes the app name so several apps don't clash.
+func sampleDataCommand(appName string) string {
+	return "create_" + appName + "_sample_data"
+}
+
+// unfillableFields returns the required fields sample data can't provide:
+// relations, which need objects to point to, and uploads, which need files.
+func (s *modelSpec) unfillableFields() []string {
+	var fields []string
+	for _, field := range s.fields {
+		switch field.kind {
+		case "fk", "o2o", "image", "file":
+			if !field.optional {
+				fields = append(fields, field.name)
+			}
+		}
+	}
+	return fields
+}
+
+// writeFixture adds the model's objects to the fixture at path, replacing
+// those of an earlier model of the same name.
+func (s *modelSpec) writeFixture(path string) error {
+	var objects []fixtureObject
+	if content, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(content, &objects); err != nil {
+			return fmt.Errorf("failed to parse %s: %v", path, err)
+		}
+	}
+	label := s.fixtureLabel()
+	kept := objects[:0]
+	for _, object := range objects {
+		if object.Model != label {
+			kept = append(kept, object)
+		}
+	}
+	content, err := json.MarshalIndent(append(kept, s.fixtureObjects()...), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to render the %s fixture: %v", s.name, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %v", path, err)
+	}
+	if err := os.WriteFile(path, append(content, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %v", path, err)
+	}
+	return nil
+}
+
+// sampleDataFiles lists the files writeSampleData adds to appPath.
+func (m *Model) sampleDataFiles(appPath, appName string) []string {
+	files := []string{fixturePath(appPath, appName)}
+	if m.sampleRows > 0 {
+		files = append(files,
+			filepath.Join(appPath, "factories.py"),
+			filepath.Join(appPath, "management", "commands", sampleDataCommand(appName)+".py"),
+		)
+	}
+	return files
+}
+
+// loadSampleData loads every app's fixture and generates the fake rows asked
+// for with factory_boy, after the migrations have run.
+func (m *Model) loadSampleData(projectPath string) error {
+	apps := m.sampleDataApps()
+	if len(apps) == 0 {
+		return nil
+	}
+	m.updateProgress("Loading sample data...")
+	pythonPath := getPythonPath(projectPath)
+
+	cmd := exec.Command(pythonPath, append([]string{"manage.py", "loaddata"}, apps...)...)
+	cmd.Dir = projectPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to load fixtures: %v\nOutput: %s", err, string(output))
+	}
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Loaded the fixtures of %s.", strings.Join(apps, ", ")))
+
+	if m.sampleRows == 0 {
+		return nil
+	}
+	cmd = exec.Command(pythonPath, "-m", "pip", "install", "factory-boy")
+	cmd.Dir = projectPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to install factory-boy: %v\nOutput: %s", err, string(output))
+	}
+	for _, appName := range apps {
+		cmd = exec.Command(pythonPath, "manage.py", sampleDataCommand(appName))
+		cmd.Dir = projectPath
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to create sample data for %s: %v\nOutput: %s", appName, err, string(output))
+		}
+	}
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Created %d fake rows per model; run 'python manage.py create_<app>_sample_data --count N' for more.", m.sampleRows))
+	return nil
+}
+
+// fixtureObject is one entry of a Django JSON fixture.
+type fixtureObject struct {
+	Model  string                 `json:"model"`
+	PK     int                    `json:"pk"`
+	Fields map[string]interface{} `json:"fields"`
+}
+
+// fixtureLabel is the app_label.modelname a fixture identifies the model by.
+func (s *modelSpec) fixtureLabel() string {
+	return s.app + "." + s.varName()
+}
+
+// fixtureObjects returns fixtureRows objects of the model. Loading a fixture
+// skips auto_now_add, so the timestamps are written out; optional uploads
+// and relations are left empty.
+func (s *modelSpec) fixtureObjects() []fixtureObject {
+	objects := make([]fixtureObject, 0, fixtureRows)
+	for n := 1; n <= fixtureRows; n++ {
+		fields := map[string]interface{}{}
+		for _, field := range s.fields {
+			if value, ok := s.fixtureValue(field, n); ok {
+				fields[field.name] = value
+			}
+		}
+		if s.timestamps {
+			timestamp := fmt.Sprintf("2025-01-%02dT09:00:00Z", n)
+			fields["created_at"] = timestamp
+			fields["updated_at"] = timestamp
+		}
+		objects = append(objects, fixtureObject{Model: s.fixtureLabel(), PK: n, Fields: fields})
+	}
+	return objects
+}
+
+// fixtureValue is the value of field in the nth fixture object.
+func (s *modelSpec) fixtureValue(field modelField, n int) (interface{}, bool) {
+	label := strings.ReplaceAll(field.name, "_", " ")
+	switch field.kind {
+	case "char":
+		value := fmt.Sprintf("Sample %s %d", strings.ToLower(s.name), n)
+		if field.name != s.strField() {
+			value = fmt.Sprintf("%s %d", titleWords(field.name), n)
+		}
+		if maxLength, err := strconv.Atoi(field.arg); err == nil && len(value) > maxLength {
+			value = value[:maxLength]
+		}
+		return value, true
+	case "text":
+		return fmt.Sprintf("The %s of sample %s %d.", label, strings.ToLower(s.name), n), true
+	case "slug":
+		return fmt.Sprintf("%s-%d", strings.ReplaceAll(snakeCase(s.name), "_", "-"), n), true
+	case "email":
+		return fmt.Sprintf("user%d@example.com", n), true
+	case "url":
+		return fmt.Sprintf("https://example.com/%s/%d/", s.urlSegment(), n), true
+	case "int", "posint", "bigint":
+		return n * 10, true
+	case "float":
+		return float64(n) * 1.5, true
+	case "decimal":
+		return fmt.Sprintf("%d.00", n*10), true
+	case "bool":
+		return n%2 == 1, true
+	case "date":
+		return fmt.Sprintf("2025-01-%02d", n), true
+	case "datetime":
+		return fmt.Sprintf("2025-01-%02dT09:00:00Z", n), true
+	case "time":
+		return fmt.Sprintf("%02d:00:00", 8+n), true
+	case "uuid":
+		return fmt.Sprintf("00000000-0000-4000-8000-%012d", n), true
+	case "json":
+		return map[string]interface{}{}, true
+	}
+	return nil, false
+}
+
+// factorySource renders a factory_boy factory filling the model's fields
+// with Faker data. Unique fields get a sequence instead, so large batches
+// don't collide; optional uploads and relations are left empty.
+func (s *modelSpec) factorySource() pythonSource {
+	imports := []string{"import factory", "from .models import " + s.name}
+	var b strings.Builder
+	fmt.Fprintf(&b, "class %[1]sFactory(factory.django.DjangoModelFactory):\n    class Meta:\n        model = %[1]s\n\n", s.name)
+	for _, field := range s.fields {
+		if declaration := s.factoryDeclaration(field); declaration != "" {
+			fmt.Fprintf(&b, "    %s = %s\n", field.name, declaration)
+			if field.kind == "datetime" && !contains(imports, "import datetime") {
+				imports = append([]string{"import datetime"}, imports...)
+			}
+		}
+	}
+	return pythonSource{imports: imports, body: b.String()}
+}
+
+func (s *modelSpec) factoryDeclaration(field modelField) string {
+	if field.unique {
+		switch field.kind {
+		case "char", "slug":
+			return fmt.Sprintf("factory.Sequence(lambda n: f'%s-{n}')", strings.ReplaceAll(field.name, "_", "-"))
+		case "email":
+			return "factory.Sequence(lambda n: f'user{n}@example.com')"
+		case "int", "posint", "bigint":
+			return "factory.Sequence(lambda n: n)"
+		}
+	}
+	switch field.kind {
+	case "char":
+		maxLength := 255
+		if n, err := strconv.Atoi(field.arg); err == nil {
+			maxLength = n
+		}
+		switch {
+		case maxLength < 5:
+			return fmt.Sprintf("factory.Faker('pystr', max_chars=%d)", maxLength)
+		case field.name == s.strField() && maxLength >= 60:
+			return "factory.Faker('sentence', nb_words=4)"
+		default:
+			return fmt.Sprintf("factory.Faker('text', max_nb_chars=%d)", min(maxLength, 200))
+		}
+	case "text":
+		return "factory.Faker('paragraph', nb_sentences=3)"
+	case "slug":
+		return "factory.Faker('slug')"
+	case "email":
+		return "factory.Faker('email')"
+	case "url":
+		return "factory.Faker('url')"
+	case "int", "posint", "bigint":
+		return "factory.Faker('random_int', min=0, max=1000)"
+	case "float":
+		return "factory.Faker('pyfloat', positive=True, max_value=1000)"
+	case "decimal":
+		digits, places := 10, 2
+		if d, p, found := strings.Cut(field.arg, ","); found {
+			digits, _ = strconv.Atoi(d)
+			places, _ = strconv.Atoi(p)
+		}
+		return fmt.Sprintf("factory.Faker('pydecimal', left_digits=%d, right_digits=%d, positive=True)", min(digits-places, 6), places)
+	case "bool":
+		return "factory.Faker('pybool')"
+	case "date":
+		return "factory.Faker('date_this_decade')"
+	case "datetime":
+		return "factory.Faker('date_time_this_year', tzinfo=datetime.timezone.utc)"
+	case "time":
+		return "factory.Faker('time_object')"
+	case "json":
+		return "factory.Faker('pydict', nb_elements=3, value_types=[str, int])"
+	}
+	return ""
+}
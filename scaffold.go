@@ -0,0 +1,651 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// modelField is one field of a model specification, written on the command
+// line as name:type[:argument][:option...], e.g. title:char:200,
+// author:fk:Author or isbn:char:13:unique.
+type modelField struct {
+	name     string
+	kind     string
+	arg      string
+	optional bool
+	unique   bool
+}
+
+// modelSpec describes a model to scaffold together with its admin, API and
+// CRUD code. Blueprints and "generate model" both render through it.
+type modelSpec struct {
+	app        string
+	module     string // import path of the app when it differs from app
+	name       string
+	fields     []modelField
+	timestamps bool
+	filters    bool // filterset, search and ordering fields on the viewset
+}
+
+// fieldKinds maps the field types accepted in a specification to the Django
+// field class they produce.
+var fieldKinds = map[string]string{
+	"char":     "CharField",
+	"text":     "TextField",
+	"slug":     "SlugField",
+	"email":    "EmailField",
+	"url":      "URLField",
+	"int":      "IntegerField",
+	"posint":   "PositiveIntegerField",
+	"bigint":   "BigIntegerField",
+	"float":    "FloatField",
+	"decimal":  "DecimalField",
+	"bool":     "BooleanField",
+	"date":     "DateField",
+	"datetime": "DateTimeField",
+	"time":     "TimeField",
+	"uuid":     "UUIDField",
+	"json":     "JSONField",
+	"image":    "ImageField",
+	"file":     "FileField",
+	"fk":       "ForeignKey",
+	"o2o":      "OneToOneField",
+	"m2m":      "ManyToManyField",
+}
+
+func isRelation(kind string) bool {
+	return kind == "fk" || kind == "o2o" || kind == "m2m"
+}
+
+// isTextKind reports whether empty values are stored as "" rather than NULL.
+func isTextKind(kind string) bool {
+	switch kind {
+	case "char", "text", "slug", "email", "url":
+		return true
+	}
+	return false
+}
+
+func parseModelField(spec string) (modelField, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 {
+		return modelField{}, fmt.Errorf("field '%s' must be written name:type (e.g. title:char:200)", spec)
+	}
+	field := modelField{name: parts[0], kind: strings.ToLower(parts[1])}
+	if err := validatePythonName("field", field.name); err != nil {
+		return field, err
+	}
+	if field.name == "pk" || strings.Contains(field.name, "__") || strings.HasSuffix(field.name, "_") {
+		return field, fmt.Errorf("'%s' is not a valid Django field name", field.name)
+	}
+	if _, ok := fieldKinds[field.kind]; !ok {
+		return field, fmt.Errorf("unknown type '%s' for field '%s' (choose from %s)", field.kind, field.name, strings.Join(sortedFieldKinds(), ", "))
+	}
+
+	for _, part := range parts[2:] {
+		switch part {
+		case "optional":
+			field.optional = true
+		case "unique":
+			field.unique = true
+		default:
+			if field.arg != "" {
+				return field, fmt.Errorf("unexpected '%s' in field '%s'", part, spec)
+			}
+			field.arg = part
+		}
+	}
+
+	switch field.kind {
+	case "char", "slug":
+		if field.arg != "" {
+			if n, err := strconv.Atoi(field.arg); err != nil || n <= 0 {
+				return field, fmt.Errorf("max length of field '%s' must be a positive number, got '%s'", field.name, field.arg)
+			}
+		}
+	case "decimal":
+		if field.arg != "" {
+			digits, places, found := strings.Cut(field.arg, ",")
+			d, err1 := strconv.Atoi(digits)
+			p, err2 := strconv.Atoi(places)
+			if !found || err1 != nil || err2 != nil || p > d {
+				return field, fmt.Errorf("decimal field '%s' takes digits,places (e.g. 10,2), got '%s'", field.name, field.arg)
+			}
+		}
+	case "fk", "o2o", "m2m":
+		if field.arg == "" {
+			return field, fmt.Errorf("relation '%s' needs a target model, e.g. %s:%s:Author", field.name, field.name, field.kind)
+		}
+	default:
+		if field.arg != "" {
+			return field, fmt.Errorf("field '%s' of type %s takes no argument, got '%s'", field.name, field.kind, field.arg)
+		}
+	}
+	return field, nil
+}
+
+func sortedFieldKinds() []string {
+	var kinds []string
+	for kind := range fieldKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+func newModelSpec(app, name string, fieldSpecs []string, timestamps bool) (*modelSpec, error) {
+	if err := validatePythonName("model", name); err != nil {
+		return nil, err
+	}
+	if first, size := utf8.DecodeRuneInString(name); !unicode.IsUpper(first) {
+		return nil, fmt.Errorf("model name '%s' should be CapWords, e.g. %s", name, string(unicode.ToUpper(first))+name[size:])
+	}
+
+	spec := &modelSpec{app: app, name: name, timestamps: timestamps}
+	var seen []string
+	if timestamps {
+		seen = []string{"created_at", "updated_at"}
+	}
+	for _, fieldSpec := range fieldSpecs {
+		field, err := parseModelField(fieldSpec)
+		if err != nil {
+			return nil, err
+		}
+		if contains(seen, field.name) {
+			return nil, fmt.Errorf("field '%s' is defined more than once", field.name)
+		}
+		seen = append(seen, field.name)
+		spec.fields = append(spec.fields, field)
+	}
+	return spec, nil
+}
+
+// varName is the lowercased model name Django uses for templates and that
+// the generated URL names are built from.
+func (s *modelSpec) varName() string {
+	return strings.ToLower(s.name)
+}
+
+// urlSegment is the plural, hyphenated model name used in URL paths.
+func (s *modelSpec) urlSegment() string {
+	return strings.ReplaceAll(pluralize(snakeCase(s.name)), "_", "-")
+}
+
+func (s *modelSpec) verbosePlural() string {
+	return titleWords(pluralize(snakeCase(s.name)))
+}
+
+func (s *modelSpec) hasFileFields() bool {
+	for _, field := range s.fields {
+		if field.kind == "image" || field.kind == "file" {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *modelSpec) hasImageFields() bool {
+	for _, field := range s.fields {
+		if field.kind == "image" {
+			return true
+		}
+	}
+	return false
+}
+
+// relationTarget renders the model a relation points to: the configured
+// user model for "User", a lazy reference for everything else.
+func relationTarget(target string) string {
+	if strings.EqualFold(target, "user") {
+		return "settings.AUTH_USER_MODEL"
+	}
+	return "'" + target + "'"
+}
+
+func (s *modelSpec) fieldDefinition(field modelField) string {
+	var args []string
+	switch field.kind {
+	case "char", "slug":
+		maxLength := field.arg
+		if maxLength == "" {
+			maxLength = Ternary(field.kind == "char", "255", "50")
+		}
+		args = append(args, "max_length="+maxLength)
+	case "decimal":
+		digits, places := "10", "2"
+		if field.arg != "" {
+			digits, places, _ = strings.Cut(field.arg, ",")
+		}
+		args = append(args, "max_digits="+digits, "decimal_places="+places)
+	case "bool":
+		args = append(args, "default=False")
+	case "uuid":
+		args = append(args, "default=uuid.uuid4", "editable=False")
+	case "json":
+		args = append(args, "default=dict")
+	case "image", "file":
+		args = append(args, fmt.Sprintf("upload_to='%s/'", s.app))
+	case "fk", "o2o":
+		args = append(args, relationTarget(field.arg))
+		if field.optional {
+			args = append(args, "on_delete=models.SET_NULL")
+		} else {
+			args = append(args, "on_delete=models.CASCADE")
+		}
+	case "m2m":
+		args = append(args, relationTarget(field.arg))
+	}
+
+	if field.unique {
+		args = append(args, "unique=True")
+	}
+	if field.optional {
+		switch {
+		case isTextKind(field.kind) || field.kind == "m2m" || field.kind == "image" || field.kind == "file":
+			args = append(args, "blank=True")
+		case field.kind != "bool":
+			args = append(args, "null=True", "blank=True")
+		}
+	}
+	return fmt.Sprintf("%s = models.%s(%s)", field.name, fieldKinds[field.kind], strings.Join(args, ", "))
+}
+
+// strField is the field __str__ returns: the first short text field.
+func (s *modelSpec) strField() string {
+	for _, field := range s.fields {
+		switch field.kind {
+		case "char", "slug", "email", "url":
+			return field.name
+		}
+	}
+	return ""
+}
+
+// modelSource renders the model class. With absoluteURL the model links to
+// the detail view generated for the CRUD scaffolding.
+func (s *modelSpec) modelSource(absoluteURL bool) pythonSource {
+	var imports []string
+	for _, field := range s.fields {
+		if field.kind == "uuid" && !contains(imports, "import uuid") {
+			imports = append(imports, "import uuid")
+		}
+	}
+	for _, field := range s.fields {
+		if isRelation(field.kind) && strings.EqualFold(field.arg, "user") {
+			imports = append(imports, "from django.conf import settings")
+			break
+		}
+	}
+	imports = append(imports, "from django.db import models")
+	if absoluteURL {
+		imports = append(imports, "from django.urls import reverse")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "class %s(models.Model):\n", s.name)
+	for _, field := range s.fields {
+		b.WriteString("    " + s.fieldDefinition(field) + "\n")
+	}
+	if s.timestamps {
+		b.WriteString("    created_at = models.DateTimeField(auto_now_add=True)\n")
+		b.WriteString("    updated_at = models.DateTimeField(auto_now=True)\n")
+		b.WriteString("\n    class Meta:\n        ordering = ['-created_at']\n")
+	}
+	if len(s.fields) == 0 && !s.timestamps {
+		b.WriteString("    pass\n")
+	}
+
+	if strField := s.strField(); strField != "" {
+		fmt.Fprintf(&b, "\n    def __str__(self):\n        return self.%s\n", strField)
+	} else {
+		b.WriteString("\n    def __str__(self):\n        return f'{self._meta.verbose_name} {self.pk}'\n")
+	}
+	if absoluteURL {
+		fmt.Fprintf(&b, "\n    def get_absolute_url(self):\n        return reverse('%s:%s_detail', args=[self.pk])\n", s.app, s.varName())
+	}
+	return pythonSource{imports: imports, body: b.String()}
+}
+
+// pythonTuple joins items for a tuple literal, adding the trailing comma a
+// one-element tuple needs.
+func pythonTuple(items []string) string {
+	if len(items) == 1 {
+		return items[0] + ","
+	}
+	return strings.Join(items, ", ")
+}
+
+func (s *modelSpec) serializerSource() pythonSource {
+	var b strings.Builder
+	fmt.Fprintf(&b, "class %[1]sSerializer(serializers.ModelSerializer):\n    class Meta:\n        model = %[1]s\n        fields = '__all__'\n", s.name)
+	if s.timestamps {
+		b.WriteString("        read_only_fields = ('created_at', 'updated_at')\n")
+	}
+	return pythonSource{
+		imports: []string{"from rest_framework import serializers", "from .models import " + s.name},
+		body:    b.String(),
+	}
+}
+
+// viewSetFilters picks the fields the viewset filters, searches and orders
+// on from their types, like adminSource does for the admin.
+func (s *modelSpec) viewSetFilters() (filterset, search, ordering []string) {
+	for _, field := range s.adminFields() {
+		quoted := "'" + field.name + "'"
+		switch field.kind {
+		case "bool", "date", "datetime", "int", "posint", "bigint", "decimal", "float", "fk", "o2o":
+			filterset = append(filterset, quoted)
+		}
+		switch field.kind {
+		case "char", "slug", "email", "url", "text":
+			search = append(search, quoted)
+		}
+		switch field.kind {
+		case "char", "slug", "email", "int", "posint", "bigint", "decimal", "float", "date", "datetime", "time", "bool":
+			ordering = append(ordering, quoted)
+		}
+	}
+	return filterset, search, ordering
+}
+
+func (s *modelSpec) viewSetSource() pythonSource {
+	imports := []string{"from rest_framework import viewsets"}
+	var b strings.Builder
+	fmt.Fprintf(&b, "class %[1]sViewSet(viewsets.ModelViewSet):\n    queryset = %[1]s.objects.all()\n    serializer_class = %[1]sSerializer\n", s.name)
+	if s.filters {
+		filterset, search, ordering := s.viewSetFilters()
+		for _, attr := range []struct {
+			name   string
+			fields []string
+		}{{"filterset_fields", filterset}, {"search_fields", search}, {"ordering_fields", ordering}} {
+			if len(attr.fields) > 0 {
+				fmt.Fprintf(&b, "    %s = [%s]\n", attr.name, strings.Join(attr.fields, ", "))
+			}
+		}
+		if s.timestamps {
+			b.WriteString("    ordering = ['-created_at']\n")
+		}
+	}
+	if s.timestamps {
+		imports = []string{
+			"from datetime import timedelta",
+			"from django.utils import timezone",
+			"from rest_framework import viewsets",
+			"from rest_framework.decorators import action",
+			"from rest_framework.response import Response",
+		}
+		b.WriteString(`
+    @action(detail=False, methods=['get'])
+    def recent(self, request):
+        recent = self.get_queryset().filter(
+            created_at__gte=timezone.now() - timedelta(days=30)
+        )
+        serializer = self.get_serializer(recent, many=True)
+        return Response(serializer.data)
+`)
+	}
+	imports = append(imports, "from .models import "+s.name, "from .serializers import "+s.name+"Serializer")
+	return pythonSource{imports: imports, body: b.String()}
+}
+
+// apiRoute returns the import and router registration for the model's
+// viewset in the project's api.py, served under prefix.
+func (s *modelSpec) apiRoute(prefix string) (importLine, registerLine string) {
+	importLine = fmt.Sprintf("from %s.views import %sViewSet", s.appModule(), s.name)
+	registerLine = fmt.Sprintf("router.register(r'%s', %sViewSet, basename='%s')", prefix, s.name, s.varName())
+	return importLine, registerLine
+}
+
+func (s *modelSpec) formSource() pythonSource {
+	var fields []string
+	for _, field := range s.fields {
+		if field.kind != "uuid" {
+			fields = append(fields, "'"+field.name+"'")
+		}
+	}
+	return pythonSource{
+		imports: []string{"from django import forms", "from .models import " + s.name},
+		body: fmt.Sprintf("class %[1]sForm(forms.ModelForm):\n    class Meta:\n        model = %[1]s\n        fields = [%[2]s]\n",
+			s.name, strings.Join(fields, ", ")),
+	}
+}
+
+func (s *modelSpec) crudViewsSource() pythonSource {
+	return pythonSource{
+		imports: []string{
+			"from django.urls import reverse_lazy",
+			"from django.views.generic import CreateView, DeleteView, DetailView, ListView, UpdateView",
+			"from .forms import " + s.name + "Form",
+			"from .models import " + s.name,
+		},
+		body: fmt.Sprintf(`class %[1]sListView(ListView):
+    model = %[1]s
+    paginate_by = 20
+
+
+class %[1]sDetailView(DetailView):
+    model = %[1]s
+
+
+class %[1]sCreateView(CreateView):
+    model = %[1]s
+    form_class = %[1]sForm
+
+
+class %[1]sUpdateView(UpdateView):
+    model = %[1]s
+    form_class = %[1]sForm
+
+
+class %[1]sDeleteView(DeleteView):
+    model = %[1]s
+    success_url = reverse_lazy('%[2]s:%[3]s_list')
+`, s.name, s.app, s.varName()),
+	}
+}
+
+// crudURLPatterns returns the URL patterns for the CRUD views, under prefix
+// within the app's URLconf.
+func (s *modelSpec) crudURLPatterns(prefix string) []string {
+	routes := []struct{ path, view, name string }{
+		{"", "ListView", "list"},
+		{"new/", "CreateView", "create"},
+		{"<int:pk>/", "DetailView", "detail"},
+		{"<int:pk>/edit/", "UpdateView", "update"},
+		{"<int:pk>/delete/", "DeleteView", "delete"},
+	}
+	var patterns []string
+	for _, route := range routes {
+		patterns = append(patterns, fmt.Sprintf("path('%s%s', views.%s%s.as_view(), name='%s_%s')",
+			prefix, route.path, s.name, route.view, s.varName(), route.name))
+	}
+	return patterns
+}
+
+// crudTemplates returns the templates Django's generic views look up, keyed
+// by file name within templates/<app>/.
+func (s *modelSpec) crudTemplates(projectPath string) map[string]string {
+	url := func(name string) string {
+		return fmt.Sprintf("{%% url '%s:%s_%s' %%}", s.app, s.varName(), name)
+	}
+	objectURL := func(name string) string {
+		return fmt.Sprintf("{%% url '%s:%s_%s' object.pk %%}", s.app, s.varName(), name)
+	}
+	enctype := ""
+	if s.hasFileFields() {
+		enctype = ` enctype="multipart/form-data"`
+	}
+
+	var details []string
+	for _, field := range s.fields {
+		value := "{{ object." + field.name + " }}"
+		switch field.kind {
+		case "text":
+			value = "{{ object." + field.name + "|linebreaksbr }}"
+		case "m2m":
+			value = "{{ object." + field.name + ".all|join:\", \" }}"
+		case "image":
+			value = "{% if object." + field.name + " %}<img src=\"{{ object." + field.name + ".url }}\" alt=\"\">{% endif %}"
+		case "file":
+			value = "{% if object." + field.name + " %}<a href=\"{{ object." + field.name + ".url }}\">{{ object." + field.name + ".name }}</a>{% endif %}"
+		}
+		label := strings.ReplaceAll(field.name, "_", " ")
+		details = append(details, fmt.Sprintf("    <dt>%s</dt>\n    <dd>%s</dd>", strings.ToUpper(label[:1])+label[1:], value))
+	}
+
+	return map[string]string{
+		s.varName() + "_list.html": appPage(projectPath, s.verbosePlural(), fmt.Sprintf(`<h1>%[1]s</h1>
+<p><a href="%[2]s">New %[3]s</a></p>
+<ul>
+    {%% for object in object_list %%}
+    <li><a href="{{ object.get_absolute_url }}">{{ object }}</a></li>
+    {%% empty %%}
+    <li>Nothing here yet.</li>
+    {%% endfor %%}
+</ul>
+{%% if is_paginated %%}
+<nav>
+    {%% if page_obj.has_previous %%}<a href="?page={{ page_obj.previous_page_number }}">Previous</a>{%% endif %%}
+    Page {{ page_obj.number }} of {{ page_obj.paginator.num_pages }}
+    {%% if page_obj.has_next %%}<a href="?page={{ page_obj.next_page_number }}">Next</a>{%% endif %%}
+</nav>
+{%% endif %%}`, s.verbosePlural(), url("create"), s.varName())),
+		s.varName() + "_detail.html": appPage(projectPath, "{{ object }}", fmt.Sprintf(`<h1>{{ object }}</h1>
+<dl>
+%s
+</dl>
+<p>
+    <a href="%s">Edit</a>
+    <a href="%s">Delete</a>
+    <a href="%s">Back to list</a>
+</p>`, strings.Join(details, "\n"), objectURL("update"), objectURL("delete"), url("list"))),
+		s.varName() + "_form.html": appPage(projectPath, s.name, fmt.Sprintf(`<h1>{%% if object %%}Edit {{ object }}{%% else %%}New %s{%% endif %%}</h1>
+<form method="post"%s>
+    {%% csrf_token %%}
+    {{ form.as_p }}
+    <button type="submit">Save</button>
+    <a href="%s">Cancel</a>
+</form>`, s.varName(), enctype, url("list"))),
+		s.varName() + "_confirm_delete.html": appPage(projectPath, "Delete {{ object }}", `<h1>Delete {{ object }}?</h1>
+<form method="post">
+    {% csrf_token %}
+    <button type="submit">Delete</button>
+    <a href="{{ object.get_absolute_url }}">Cancel</a>
+</form>`),
+	}
+}
+
+// inputFields are the fields API clients send when creating or updating:
+// generated values, uploads and many-to-many relations are left out.
+func (s *modelSpec) inputFields() []modelField {
+	var fields []modelField
+	for _, field := range s.fields {
+		switch field.kind {
+		case "uuid", "image", "file", "m2m":
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// appModule returns the import path of the model's app.
+func (s *modelSpec) appModule() string {
+	if s.module == "" {
+		return s.app
+	}
+	return s.module
+}
+
+// writeModel adds the model and its admin registration to the app.
+func (s *modelSpec) writeModel(projectPath string, absoluteURL bool) error {
+	appPath := moduleDir(projectPath, s.appModule())
+	if err := writePythonSource(filepath.Join(appPath, "models.py"), s.modelSource(absoluteURL)); err != nil {
+		return err
+	}
+	return writePythonSource(filepath.Join(appPath, "admin.py"), s.adminSource())
+}
+
+// writeAPI adds the serializer and viewset to the app. Registering the
+// viewset on the project's router is left to the caller.
+func (s *modelSpec) writeAPI(projectPath string) error {
+	appPath := moduleDir(projectPath, s.appModule())
+	if err := writePythonSource(filepath.Join(appPath, "serializers.py"), s.serializerSource()); err != nil {
+		return err
+	}
+	return writePythonSource(filepath.Join(appPath, "views.py"), s.viewSetSource())
+}
+
+// writeCRUD adds the form, views, templates and URL patterns to the app,
+// creating the app's urls.py if it has none. It reports whether urls.py was
+// created, in which case the app still has to be mounted.
+func (s *modelSpec) writeCRUD(projectPath, urlPrefix string) (bool, error) {
+	appPath := moduleDir(projectPath, s.appModule())
+	if err := writePythonSource(filepath.Join(appPath, "forms.py"), s.formSource()); err != nil {
+		return false, err
+	}
+	if err := writePythonSource(filepath.Join(appPath, "views.py"), s.crudViewsSource()); err != nil {
+		return false, err
+	}
+	for name, content := range s.crudTemplates(projectPath) {
+		if err := writeAppFile(appPath, content, "templates", s.app, name); err != nil {
+			return false, err
+		}
+	}
+
+	patterns := s.crudURLPatterns(urlPrefix)
+	urlsPath := filepath.Join(appPath, "urls.py")
+	urlsContent, err := os.ReadFile(urlsPath)
+	if os.IsNotExist(err) {
+		content := fmt.Sprintf("from django.urls import path\n\nfrom . import views\n\napp_name = '%s'\nurlpatterns = [\n    %s,\n]\n",
+			s.app, strings.Join(patterns, ",\n    "))
+		if err := os.WriteFile(urlsPath, []byte(content), 0644); err != nil {
+			return false, fmt.Errorf("failed to create urls.py for app %s: %v", s.app, err)
+		}
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read urls.py for app %s: %v", s.app, err)
+	}
+
+	content := addPythonImport(string(urlsContent), "from . import views")
+	for _, pattern := range patterns {
+		if content, err = addURLPattern(content, pattern); err != nil {
+			return false, err
+		}
+	}
+	if err := os.WriteFile(urlsPath, []byte(content), 0644); err != nil {
+		return false, fmt.Errorf("failed to update urls.py for app %s: %v", s.app, err)
+	}
+	return false, nil
+}
+
+// snakeCase turns a CapWords model name into snake_case: BlogPost becomes
+// blog_post.
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func pluralize(word string) string {
+	switch {
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !strings.ContainsRune("aeiou", rune(word[len(word)-2])):
+		return strings.TrimSuffix(word, "y") + "ies"
+	case strings.HasSuffix(word, "s"), strings.HasSuffix(word, "x"), strings.HasSuffix(word, "z"),
+		strings.HasSuffix(word, "ch"), strings.HasSuffix(word, "sh"):
+		return word + "es"
+	}
+	return word + "s"
+}
@@ -0,0 +1,54 @@
+package main
+
+import "testing"
+
+func TestParseModelField(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    modelField
+		wantErr bool
+	}{
+		{spec: "title:char", want: modelField{name: "title", kind: "char"}},
+		{spec: "title:CHAR:200", want: modelField{name: "title", kind: "char", arg: "200"}},
+		{spec: "slug:slug:80:unique", want: modelField{name: "slug", kind: "slug", arg: "80", unique: true}},
+		{spec: "price:decimal:10,2:optional", want: modelField{name: "price", kind: "decimal", arg: "10,2", optional: true}},
+		{spec: "email:email:optional:unique", want: modelField{name: "email", kind: "email", optional: true, unique: true}},
+		{spec: "email:email:unique:optional", want: modelField{name: "email", kind: "email", optional: true, unique: true}},
+		{spec: "author:fk:Author:optional", want: modelField{name: "author", kind: "fk", arg: "Author", optional: true}},
+		{spec: "tags:m2m:Tag", want: modelField{name: "tags", kind: "m2m", arg: "Tag"}},
+		{spec: "título:text", want: modelField{name: "título", kind: "text"}},
+		{spec: "title", wantErr: true},
+		{spec: "title:string", wantErr: true},
+		{spec: "class:char", wantErr: true},
+		{spec: "None:char", wantErr: true},
+		{spec: "pk:integer", wantErr: true},
+		{spec: "first__name:char", wantErr: true},
+		{spec: "name_:char", wantErr: true},
+		{spec: "2nd:char", wantErr: true},
+		{spec: "title:char:0", wantErr: true},
+		{spec: "title:char:abc", wantErr: true},
+		{spec: "title:char:200:300", wantErr: true},
+		{spec: "price:decimal:2,10", wantErr: true},
+		{spec: "price:decimal:10", wantErr: true},
+		{spec: "author:fk", wantErr: true},
+		{spec: "author:fk:optional", wantErr: true},
+		{spec: "published:bool:yes", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := parseModelField(tt.spec)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
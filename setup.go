@@ -68,12 +68,12 @@ func (m *Model) installDjango(projectPath string) error {
 
 func (m *Model) createDjangoProject(projectPath string) error {
 	pythonVenvPath := getPythonPath(projectPath)
-	cmd := exec.Command(pythonVenvPath, "-m", "django", "startproject", m.projectName, ".")
+	cmd := exec.Command(pythonVenvPath, "-m", "django", "startproject", m.packageName, ".")
 	cmd.Dir = projectPath
 	if output, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("failed to create Django project: %v\nOutput: %s", err, string(output))
 	}
-	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Django project '%s' created.", m.projectName))
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Django project '%s' created.", m.packageName))
 	m.updateProgress("Creating Django project...")
 	return nil
 }
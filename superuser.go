@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+func (m *Model) createSuperuser(projectPath string) error {
+	if m.superuserName == "" {
+		return nil
+	}
+
+	m.updateProgress("Creating superuser...")
+	pythonPath := getPythonPath(projectPath)
+
+	// createsuperuser reads the credentials from the environment in --noinput
+	// mode, which keeps the password off the command line.
+	cmd := exec.Command(pythonPath, "manage.py", "createsuperuser", "--noinput")
+	cmd.Dir = projectPath
+	cmd.Env = append(os.Environ(),
+		"DJANGO_SUPERUSER_USERNAME="+m.superuserName,
+		"DJANGO_SUPERUSER_EMAIL="+m.superuserEmail,
+		"DJANGO_SUPERUSER_PASSWORD="+m.superuserPassword,
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to create superuser '%s': %v\nOutput: %s", m.superuserName, err, m.redactPassword(string(output)))
+	}
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Created superuser '%s'.", m.superuserName))
+	return nil
+}
+
+// redactPassword masks the superuser password in command output before it is
+// shown to the user.
+func (m *Model) redactPassword(output string) string {
+	if m.superuserPassword == "" {
+		return output
+	}
+	return strings.ReplaceAll(output, m.superuserPassword, "********")
+}
+
+func validateSuperuserEmail(email string) error {
+	if email == "" {
+		return nil
+	}
+	at := strings.Index(email, "@")
+	if at < 1 || at == len(email)-1 || strings.ContainsAny(email, " \t") {
+		return fmt.Errorf("'%s' is not a valid email address", email)
+	}
+	return nil
+}
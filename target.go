@@ -0,0 +1,157 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// projectDir returns the absolute directory the project is generated in:
+// <parent>/<project name>, or the parent itself when scaffolding in place.
+func (m *Model) projectDir() (string, error) {
+	dir := filepath.Join(m.parentDir, m.projectName)
+	if m.inPlace {
+		dir = m.parentDir
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve project directory: %v", err)
+	}
+	return absDir, nil
+}
+
+// validateProjectDir validates the project name as a directory under the
+// chosen parent. Existing directories are allowed when scaffolding in place
+// or with --force; CreateProject then checks the individual files.
+func (m *Model) validateProjectDir(name string) error {
+	if err := validateProjectName(name); err != nil {
+		return err
+	}
+	if m.inPlace || m.force {
+		return nil
+	}
+	if _, err := os.Stat(filepath.Join(m.parentDir, name)); err == nil {
+		return fmt.Errorf("directory '%s' already exists (use --force to scaffold into it)", name)
+	}
+	return nil
+}
+
+func startprojectFiles(packageName string) []string {
+	return []string{
+		"manage.py",
+		filepath.Join(packageName, "__init__.py"),
+		filepath.Join(packageName, "settings.py"),
+		filepath.Join(packageName, "urls.py"),
+		filepath.Join(packageName, "asgi.py"),
+		filepath.Join(packageName, "wsgi.py"),
+	}
+}
+
+// startappFiles lists the files startapp writes to appPath.
+func startappFiles(appPath string) []string {
+	return []string{
+		filepath.Join(appPath, "__init__.py"),
+		filepath.Join(appPath, "admin.py"),
+		filepath.Join(appPath, "apps.py"),
+		filepath.Join(appPath, "models.py"),
+		filepath.Join(appPath, "tests.py"),
+		filepath.Join(appPath, "views.py"),
+		filepath.Join(appPath, "migrations", "__init__.py"),
+	}
+}
+
+// generatedFiles lists the files, relative to the project directory, that
+// the selected options write.
+func (m *Model) generatedFiles() []string {
+	files := startprojectFiles(m.packageName)
+	if !m.apiOnly {
+		files = append(files,
+			filepath.Join(m.packageName, "context_processors.py"),
+			filepath.Join(m.packageName, "views.py"),
+		)
+	}
+
+	if m.nestedApps && (m.setupCustomUser || len(m.appNames) > 0) {
+		files = append(files, filepath.Join(appsPackage, "__init__.py"))
+	}
+	if m.setupCustomUser {
+		files = append(files, startappFiles(m.appDir("", customUserApp))...)
+	}
+	for _, appName := range m.appNames {
+		files = append(files, startappFiles(m.appDir("", appName))...)
+		files = append(files, m.blueprintFiles(m.appDir("", appName), appName, m.blueprintFor(appName))...)
+	}
+
+	if m.createTemplates {
+		files = append(files,
+			filepath.Join("templates", "base.html"),
+			filepath.Join("templates", "index.html"),
+			filepath.Join("static", "css", "style.css"),
+			filepath.Join("static", "js", "main.js"),
+		)
+		if !m.hasGeneratedAPIDocs() {
+			files = append(files, filepath.Join("templates", "api-docs.html"))
+		}
+	}
+	if m.setupTailwind {
+		files = append(files, "package.json", filepath.Join("static", "src", "styles.css"))
+	}
+	if m.setupAPI {
+		files = append(files, filepath.Join(m.packageName, "api.py"))
+	}
+	if m.setupOpenAPI {
+		files = append(files, apiSchemaFile)
+	}
+	if m.setupGraphQL {
+		files = append(files, filepath.Join(m.packageName, graphQLSchemaFile))
+		for _, spec := range m.graphQLModels() {
+			files = append(files, filepath.Join(moduleDir("", spec.appModule()), "types.py"))
+		}
+	}
+	if m.setupProduction {
+		files = append(files, filepath.Join(m.packageName, "settings_production.py"))
+	}
+	return files
+}
+
+// conflictingFiles returns the generated files that already exist in
+// projectPath and would be overwritten.
+func (m *Model) conflictingFiles(projectPath string) []string {
+	var conflicts []string
+	for _, file := range m.generatedFiles() {
+		if _, err := os.Stat(filepath.Join(projectPath, file)); err == nil {
+			conflicts = append(conflicts, file)
+		}
+	}
+	return conflicts
+}
+
+// checkProjectDir refuses to generate into a directory with conflicting
+// files unless --force was given. With --force, the files startproject and
+// startapp refuse to overwrite are removed so those commands can run.
+func (m *Model) checkProjectDir(projectPath string) error {
+	conflicts := m.conflictingFiles(projectPath)
+	if len(conflicts) == 0 {
+		return nil
+	}
+	if !m.force {
+		return fmt.Errorf("these files already exist in %s and would be overwritten (use --force to overwrite them):\n  %s",
+			projectPath, strings.Join(conflicts, "\n  "))
+	}
+
+	djangoFiles := startprojectFiles(m.packageName)
+	for _, appName := range append([]string{customUserApp}, m.appNames...) {
+		djangoFiles = append(djangoFiles, startappFiles(m.appDir("", appName))...)
+	}
+	for _, file := range conflicts {
+		if !contains(djangoFiles, file) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(projectPath, file)); err != nil {
+			return fmt.Errorf("failed to remove %s: %v", file, err)
+		}
+	}
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("⚠️  Overwriting %d existing file(s) (--force).", len(conflicts)))
+	return nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// existingDir creates an "existing" directory in a temporary working
+// directory, where validateProjectName used to look for it.
+func existingDir(t *testing.T) string {
+	t.Helper()
+	wd := t.TempDir()
+	if err := os.Mkdir(filepath.Join(wd, "existing"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	previous, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(wd); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(previous) })
+	return wd
+}
+
+func TestValidateProjectDirForce(t *testing.T) {
+	existingDir(t)
+	m := &Model{force: true}
+	if err := m.validateProjectDir("existing"); err != nil {
+		t.Errorf("--force should allow an existing directory, got %v", err)
+	}
+	m.force = false
+	if err := m.validateProjectDir("existing"); err == nil {
+		t.Error("an existing directory should be rejected without --force")
+	}
+}
+
+func TestValidateProjectDirInPlace(t *testing.T) {
+	wd := existingDir(t)
+	m := &Model{inPlace: true, parentDir: filepath.Join(wd, "existing")}
+	if err := m.validateProjectDir("existing"); err != nil {
+		t.Errorf("scaffolding in place should allow an existing directory, got %v", err)
+	}
+}
+
+func TestValidateProjectDirOtherParent(t *testing.T) {
+	existingDir(t)
+	m := &Model{parentDir: t.TempDir()}
+	if err := m.validateProjectDir("existing"); err != nil {
+		t.Errorf("a directory of the working directory should not matter under --dir, got %v", err)
+	}
+}
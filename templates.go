@@ -21,9 +21,11 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
 	}
 
 	baseContent := `{% load static %}
+    {% load i18n %}
     {% load django_browser_reload %}
+    {% get_current_language as LANGUAGE_CODE %}
     <!DOCTYPE html>
-    <html lang="en" class="dark">
+    <html lang="{{ LANGUAGE_CODE }}" class="dark">
     <head>
         <meta charset="UTF-8">
         <meta name="viewport" content="width=device-width, initial-scale=1.0">
@@ -66,11 +68,11 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
                     </div>
 
                     <nav class="hidden md:flex items-center space-x-8">
-                        <a href="/" class="text-gray-300 hover:text-white transition-colors duration-200 text-sm">Home</a>
-                        <a href="{% url 'api_docs' %}" class="text-gray-300 hover:text-white transition-colors duration-200 text-sm">Docs</a>
-                        <a href="/admin/" class="text-gray-300 hover:text-white transition-colors duration-200 text-sm">Admin</a>
+                        <a href="/" class="text-gray-300 hover:text-white transition-colors duration-200 text-sm">{% translate "Home" %}</a>
+                        <a href="{% url 'api_docs' %}" class="text-gray-300 hover:text-white transition-colors duration-200 text-sm">{% translate "Docs" %}</a>
+                        <a href="/admin/" class="text-gray-300 hover:text-white transition-colors duration-200 text-sm">{% translate "Admin" %}</a>
                         <a href="/api/v1/" class="bg-white text-black px-4 py-2 rounded-md text-sm font-medium hover:bg-gray-200 transition-colors duration-200">
-                            API
+                            {% translate "API" %}
                         </a>
                     </nav>
 
@@ -101,33 +103,33 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
                             </svg>
                             <span class="text-lg font-semibold">{{ project_name|default:"Django Site" }}</span>
                         </div>
-                        <p class="text-gray-400 mb-6 max-w-md">The Django framework that gives you everything you need to build full-stack web applications.</p>
+                        <p class="text-gray-400 mb-6 max-w-md">{% translate "The Django framework that gives you everything you need to build full-stack web applications." %}</p>
                     </div>
 
                     <div>
-                        <h3 class="text-sm font-semibold text-white mb-4">Resources</h3>
+                        <h3 class="text-sm font-semibold text-white mb-4">{% translate "Resources" %}</h3>
                         <ul class="space-y-3">
-                            <li><a href="{% url 'api_docs' %}" class="text-gray-400 hover:text-white transition-colors text-sm">Documentation</a></li>
-                            <li><a href="/api/v1/" class="text-gray-400 hover:text-white transition-colors text-sm">API Reference</a></li>
-                            <li><a href="/admin/" class="text-gray-400 hover:text-white transition-colors text-sm">Admin Panel</a></li>
+                            <li><a href="{% url 'api_docs' %}" class="text-gray-400 hover:text-white transition-colors text-sm">{% translate "Documentation" %}</a></li>
+                            <li><a href="/api/v1/" class="text-gray-400 hover:text-white transition-colors text-sm">{% translate "API Reference" %}</a></li>
+                            <li><a href="/admin/" class="text-gray-400 hover:text-white transition-colors text-sm">{% translate "Admin Panel" %}</a></li>
                         </ul>
                     </div>
 
                     <div>
-                        <h3 class="text-sm font-semibold text-white mb-4">Support</h3>
+                        <h3 class="text-sm font-semibold text-white mb-4">{% translate "Support" %}</h3>
                         <ul class="space-y-3">
-                            <li><a href="#" class="text-gray-400 hover:text-white transition-colors text-sm">Help Center</a></li>
-                            <li><a href="#" class="text-gray-400 hover:text-white transition-colors text-sm">Contact</a></li>
-                            <li><a href="#" class="text-gray-400 hover:text-white transition-colors text-sm">Status</a></li>
+                            <li><a href="#" class="text-gray-400 hover:text-white transition-colors text-sm">{% translate "Help Center" %}</a></li>
+                            <li><a href="#" class="text-gray-400 hover:text-white transition-colors text-sm">{% translate "Contact" %}</a></li>
+                            <li><a href="#" class="text-gray-400 hover:text-white transition-colors text-sm">{% translate "Status" %}</a></li>
                         </ul>
                     </div>
                 </div>
 
                 <div class="border-t border-gray-800 mt-12 pt-8 flex flex-col md:flex-row justify-between items-center">
-                    <p class="text-gray-400 text-sm">© 2025 {{ project_name|default:"Django Site" }}. All rights reserved.</p>
+                    <p class="text-gray-400 text-sm">© 2025 {{ project_name|default:"Django Site" }}. {% translate "All rights reserved." %}</p>
                     <div class="flex space-x-6 mt-4 md:mt-0">
-                        <a href="#" class="text-gray-400 hover:text-white text-sm transition-colors">Privacy</a>
-                        <a href="#" class="text-gray-400 hover:text-white text-sm transition-colors">Terms</a>
+                        <a href="#" class="text-gray-400 hover:text-white text-sm transition-colors">{% translate "Privacy" %}</a>
+                        <a href="#" class="text-gray-400 hover:text-white text-sm transition-colors">{% translate "Terms" %}</a>
                     </div>
                 </div>
             </div>
@@ -137,12 +139,13 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
         {{ django_browser_reload_script }}
     </body>
     </html>`
-	if err := os.WriteFile(filepath.Join(globalTemplatesPath, "base.html"), []byte(baseContent), 0644); err != nil {
+	if err := os.WriteFile(filepath.Join(globalTemplatesPath, "base.html"), []byte(m.pointAPILinks(m.addGraphQLLinks(m.pointDocsLinks(baseContent)))), 0644); err != nil {
 		return fmt.Errorf("failed to create base.html: %v", err)
 	}
 
 	indexContent := `{% extends 'base.html' %}
-{% block title %}{{ project_name|default:"Django" }} - The Django Framework{% endblock %}
+{% load i18n %}
+{% block title %}{{ project_name|default:"Django" }} - {% translate "The Django Framework" %}{% endblock %}
 
 {% block content %}
 <div class="relative">
@@ -160,27 +163,27 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
             <div class="text-center">
                 <!-- Badge -->
                 <div class="inline-flex items-center rounded-full border border-gray-800 bg-gray-900/50 backdrop-blur-sm px-4 py-2 text-sm mb-8">
-                    <span class="text-gray-300">🚀 Production ready Django application</span>
+                    <span class="text-gray-300">{% translate "🚀 Production ready Django application" %}</span>
                 </div>
 
                 <!-- Main heading -->
                 <h1 class="text-5xl md:text-7xl lg:text-8xl font-bold tracking-tight mb-8">
-                    <span class="block">The Django</span>
+                    <span class="block">{% translate "The Django" %}</span>
                     <span class="block bg-gradient-to-r from-blue-400 via-purple-400 to-pink-400 bg-clip-text text-transparent">
-                        Framework
+                        {% translate "Framework" %}
                     </span>
                 </h1>
 
                 <!-- Subtitle -->
                 <p class="text-xl md:text-2xl text-gray-400 max-w-3xl mx-auto mb-12 leading-relaxed">
-                    Django provides everything you need to build fast, secure, and scalable web applications.
-                    <span class="text-white">Used by thousands of developers worldwide.</span>
+                    {% translate "Django provides everything you need to build fast, secure, and scalable web applications." %}
+                    <span class="text-white">{% translate "Used by thousands of developers worldwide." %}</span>
                 </p>
 
                 <!-- CTA Buttons -->
                 <div class="flex flex-col sm:flex-row gap-4 justify-center mb-16">
                     <a href="{% url 'api_docs' %}" class="bg-white text-black px-8 py-4 rounded-md font-semibold hover:bg-gray-200 transition-colors duration-200 inline-flex items-center justify-center">
-                        Get Started
+                        {% translate "Get Started" %}
                         <svg class="w-4 h-4 ml-2" fill="none" stroke="currentColor" viewBox="0 0 24 24">
                             <path stroke-linecap="round" stroke-linejoin="round" stroke-width="2" d="M9 5l7 7-7 7" />
                         </svg>
@@ -189,7 +192,7 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
                         <svg class="w-4 h-4 mr-2" fill="none" stroke="currentColor" viewBox="0 0 24 24">
                             <path stroke-linecap="round" stroke-linejoin="round" stroke-width="2" d="M13 10V3L4 14h7v7l9-11h-7z" />
                         </svg>
-                        Try API
+                        {% translate "Try API" %}
                     </a>
                 </div>
 
@@ -202,7 +205,7 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
                                 <div class="w-3 h-3 rounded-full bg-yellow-500"></div>
                                 <div class="w-3 h-3 rounded-full bg-green-500"></div>
                             </div>
-                            <span class="text-gray-400 text-sm">Django Project</span>
+                            <span class="text-gray-400 text-sm">{% translate "Django Project" %}</span>
                         </div>
                         <pre class="text-sm text-gray-300 font-geist-mono"><code><span class="text-purple-400">from</span> <span class="text-blue-400">django.http</span> <span class="text-purple-400">import</span> <span class="text-yellow-400">JsonResponse</span>
 
@@ -220,9 +223,9 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
     <!-- Features Section -->
     <div class="max-w-7xl mx-auto px-4 sm:px-6 lg:px-8 py-24">
         <div class="text-center mb-16">
-            <h2 class="text-3xl md:text-4xl font-bold text-white mb-4">Why Django?</h2>
+            <h2 class="text-3xl md:text-4xl font-bold text-white mb-4">{% translate "Why Django?" %}</h2>
             <p class="text-xl text-gray-400 max-w-2xl mx-auto">
-                Built for speed, security, and scalability. Trusted by startups and enterprises.
+                {% translate "Built for speed, security, and scalability. Trusted by startups and enterprises." %}
             </p>
         </div>
 
@@ -234,8 +237,8 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
                         <path stroke-linecap="round" stroke-linejoin="round" stroke-width="2" d="M13 10V3L4 14h7v7l9-11h-7z" />
                     </svg>
                 </div>
-                <h3 class="text-xl font-semibold text-white mb-3">Fast Development</h3>
-                <p class="text-gray-400">Django's batteries-included approach means you can build full-featured applications quickly without reinventing the wheel.</p>
+                <h3 class="text-xl font-semibold text-white mb-3">{% translate "Fast Development" %}</h3>
+                <p class="text-gray-400">{% translate "Django's batteries-included approach means you can build full-featured applications quickly without reinventing the wheel." %}</p>
             </div>
 
             <!-- Feature 2 -->
@@ -245,8 +248,8 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
                         <path stroke-linecap="round" stroke-linejoin="round" stroke-width="2" d="M12 15v2m-6 4h12a2 2 0 002-2v-6a2 2 0 00-2-2H6a2 2 0 00-2 2v6a2 2 0 002 2zm10-10V7a4 4 0 00-8 0v4h8z" />
                     </svg>
                 </div>
-                <h3 class="text-xl font-semibold text-white mb-3">Security First</h3>
-                <p class="text-gray-400">Built-in protection against common security threats like SQL injection, CSRF, and XSS attacks.</p>
+                <h3 class="text-xl font-semibold text-white mb-3">{% translate "Security First" %}</h3>
+                <p class="text-gray-400">{% translate "Built-in protection against common security threats like SQL injection, CSRF, and XSS attacks." %}</p>
             </div>
 
             <!-- Feature 3 -->
@@ -256,8 +259,8 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
                         <path stroke-linecap="round" stroke-linejoin="round" stroke-width="2" d="M4 7v10c0 2.21 3.582 4 8 4s8-1.79 8-4V7M4 7c0 2.21 3.582 4 8 4s8-1.79 8-4M4 7c0-2.21 3.582-4 8-4s8 1.79 8 4m0 5c0 2.21-3.582 4-8 4s-8-1.79-8-4" />
                     </svg>
                 </div>
-                <h3 class="text-xl font-semibold text-white mb-3">Scalable</h3>
-                <p class="text-gray-400">From small projects to high-traffic applications, Django scales with your needs and handles millions of users.</p>
+                <h3 class="text-xl font-semibold text-white mb-3">{% translate "Scalable" %}</h3>
+                <p class="text-gray-400">{% translate "From small projects to high-traffic applications, Django scales with your needs and handles millions of users." %}</p>
             </div>
 
             <!-- Feature 4 -->
@@ -267,8 +270,8 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
                         <path stroke-linecap="round" stroke-linejoin="round" stroke-width="2" d="M9 12h6m-6 4h6m2 5H7a2 2 0 01-2-2V5a2 2 0 012-2h5.586a1 1 0 01.707.293l5.414 5.414a1 1 0 01.293.707V19a2 2 0 01-2 2z" />
                     </svg>
                 </div>
-                <h3 class="text-xl font-semibold text-white mb-3">Rich Ecosystem</h3>
-                <p class="text-gray-400">Thousands of packages and a vibrant community provide solutions for almost any use case.</p>
+                <h3 class="text-xl font-semibold text-white mb-3">{% translate "Rich Ecosystem" %}</h3>
+                <p class="text-gray-400">{% translate "Thousands of packages and a vibrant community provide solutions for almost any use case." %}</p>
             </div>
 
             <!-- Feature 5 -->
@@ -279,8 +282,8 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
                         <path stroke-linecap="round" stroke-linejoin="round" stroke-width="2" d="M15 12a3 3 0 11-6 0 3 3 0 016 0z" />
                     </svg>
                 </div>
-                <h3 class="text-xl font-semibold text-white mb-3">Admin Interface</h3>
-                <p class="text-gray-400">Automatic admin interface for content management, user authentication, and database operations.</p>
+                <h3 class="text-xl font-semibold text-white mb-3">{% translate "Admin Interface" %}</h3>
+                <p class="text-gray-400">{% translate "Automatic admin interface for content management, user authentication, and database operations." %}</p>
             </div>
 
             <!-- Feature 6 -->
@@ -290,8 +293,8 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
                         <path stroke-linecap="round" stroke-linejoin="round" stroke-width="2" d="M8 9l3 3-3 3m5 0h3M5 20h14a2 2 0 002-2V6a2 2 0 00-2-2H5a2 2 0 00-2 2v14a2 2 0 002 2z" />
                     </svg>
                 </div>
-                <h3 class="text-xl font-semibold text-white mb-3">REST API</h3>
-                <p class="text-gray-400">Built-in support for creating powerful REST APIs with authentication, serialization, and documentation.</p>
+                <h3 class="text-xl font-semibold text-white mb-3">{% translate "REST API" %}</h3>
+                <p class="text-gray-400">{% translate "Built-in support for creating powerful REST APIs with authentication, serialization, and documentation." %}</p>
             </div>
         </div>
     </div>
@@ -302,19 +305,19 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
             <div class="grid grid-cols-2 md:grid-cols-4 gap-8 text-center">
                 <div>
                     <div class="text-4xl font-bold text-white mb-2">15+</div>
-                    <div class="text-gray-400 text-sm">Years of Development</div>
+                    <div class="text-gray-400 text-sm">{% translate "Years of Development" %}</div>
                 </div>
                 <div>
                     <div class="text-4xl font-bold text-white mb-2">1M+</div>
-                    <div class="text-gray-400 text-sm">Websites Built</div>
+                    <div class="text-gray-400 text-sm">{% translate "Websites Built" %}</div>
                 </div>
                 <div>
                     <div class="text-4xl font-bold text-white mb-2">99.9%</div>
-                    <div class="text-gray-400 text-sm">Uptime</div>
+                    <div class="text-gray-400 text-sm">{% translate "Uptime" %}</div>
                 </div>
                 <div>
                     <div class="text-4xl font-bold text-white mb-2">24/7</div>
-                    <div class="text-gray-400 text-sm">Community Support</div>
+                    <div class="text-gray-400 text-sm">{% translate "Community Support" %}</div>
                 </div>
             </div>
         </div>
@@ -324,27 +327,27 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
     <div class="border-t border-gray-800">
         <div class="max-w-4xl mx-auto px-4 sm:px-6 lg:px-8 py-24 text-center">
             <h2 class="text-3xl md:text-4xl font-bold text-white mb-6">
-                Start building today
+                {% translate "Start building today" %}
             </h2>
             <p class="text-xl text-gray-400 mb-12 max-w-2xl mx-auto">
-                Join thousands of developers who trust Django to build their next big project.
+                {% translate "Join thousands of developers who trust Django to build their next big project." %}
             </p>
             <div class="flex flex-col sm:flex-row gap-4 justify-center">
                 <a href="{% url 'api_docs' %}" class="bg-white text-black px-8 py-4 rounded-md font-semibold hover:bg-gray-200 transition-colors duration-200 inline-flex items-center justify-center">
-                    Get Started
+                    {% translate "Get Started" %}
                     <svg class="w-4 h-4 ml-2" fill="none" stroke="currentColor" viewBox="0 0 24 24">
                         <path stroke-linecap="round" stroke-linejoin="round" stroke-width="2" d="M9 5l7 7-7 7" />
                     </svg>
                 </a>
                 <a href="/admin/" class="border border-gray-700 text-white px-8 py-4 rounded-md font-semibold hover:border-gray-600 hover:bg-gray-900 transition-colors duration-200">
-                    Admin Panel
+                    {% translate "Admin Panel" %}
                 </a>
             </div>
         </div>
     </div>
 </div>
 {% endblock %}`
-	if err := os.WriteFile(filepath.Join(globalTemplatesPath, "index.html"), []byte(indexContent), 0644); err != nil {
+	if err := os.WriteFile(filepath.Join(globalTemplatesPath, "index.html"), []byte(m.pointAPILinks(m.addGraphQLLinks(m.pointDocsLinks(indexContent)))), 0644); err != nil {
 		return fmt.Errorf("failed to create index.html: %v", err)
 	}
 
@@ -372,6 +375,7 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
 	}
 
 	// Write the API docs template
+	authEndpoints, quickStart := m.apiAuthDocs()
 	apiDocsContent := `{% extends 'base.html' %}
 {% block title %}API Documentation - {{ project_name }}{% endblock %}
 
@@ -387,17 +391,17 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
             </div>
 
             <h1 class="text-5xl md:text-7xl font-bold mb-6 bg-gradient-to-r from-white via-gray-300 to-gray-500 bg-clip-text text-transparent">
-                Books API
+                {{ project_name|default:"Django" }} API
             </h1>
             <p class="text-xl text-gray-400 max-w-2xl mx-auto leading-relaxed">
-                A powerful REST API for managing your book collection with full CRUD operations, authentication, and more.
+                A REST API for the project's apps, with full CRUD operations, authentication and a browsable interface.
             </p>
         </div>
 
         <!-- Quick Stats -->
         <div class="grid grid-cols-2 md:grid-cols-4 gap-4 mb-20">
             <div class="bg-gray-900/50 border border-gray-800 rounded-xl p-6 text-center">
-                <div class="text-2xl font-bold text-white mb-1">12+</div>
+                <div class="text-2xl font-bold text-white mb-1">` + fmt.Sprint(3*len(m.appsWithBlueprint(blueprintAPI))) + `</div>
                 <div class="text-sm text-gray-400">Endpoints</div>
             </div>
             <div class="bg-gray-900/50 border border-gray-800 rounded-xl p-6 text-center">
@@ -416,72 +420,7 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
 
         <!-- API Endpoints Section -->
         <div class="space-y-12">
-            <!-- Books API -->
-            <section>
-                <div class="mb-8">
-                    <h2 class="text-3xl font-bold text-white mb-3">Books API</h2>
-                    <p class="text-gray-400">Manage your book collection with full CRUD operations</p>
-                </div>
-
-                <div class="space-y-6">
-                    <!-- GET & POST /api/v1/books/ -->
-                    <div class="bg-gray-900/30 border border-gray-800 rounded-xl overflow-hidden hover:border-gray-700 transition-colors">
-                        <div class="p-6">
-                            <div class="flex flex-col md:flex-row md:items-center justify-between mb-4">
-                                <div class="flex items-center space-x-3 mb-3 md:mb-0">
-                                    <span class="px-2 py-1 text-xs font-mono bg-green-500/20 text-green-400 border border-green-500/30 rounded">GET</span>
-                                    <span class="px-2 py-1 text-xs font-mono bg-blue-500/20 text-blue-400 border border-blue-500/30 rounded">POST</span>
-                                    <code class="text-sm font-mono text-gray-300 bg-gray-800 px-3 py-1 rounded">/api/v1/books/</code>
-                                </div>
-                            </div>
-                            <p class="text-gray-400 mb-4">Retrieve all books or create a new book entry with title, author, and publication details.</p>
-                            <div class="bg-black/50 border border-gray-800 rounded-lg p-4">
-                                <pre class="text-sm text-gray-300 font-mono">
-<span class="text-green-400">GET</span>: Returns paginated list of books
-<span class="text-blue-400">POST</span>: Creates new book (requires: title, author, isbn)</pre>
-                            </div>
-                        </div>
-                    </div>
-
-                    <!-- GET, PUT, DELETE /api/v1/books/{id}/ -->
-                    <div class="bg-gray-900/30 border border-gray-800 rounded-xl overflow-hidden hover:border-gray-700 transition-colors">
-                        <div class="p-6">
-                            <div class="flex flex-col md:flex-row md:items-center justify-between mb-4">
-                                <div class="flex items-center space-x-3 mb-3 md:mb-0">
-                                    <span class="px-2 py-1 text-xs font-mono bg-green-500/20 text-green-400 border border-green-500/30 rounded">GET</span>
-                                    <span class="px-2 py-1 text-xs font-mono bg-yellow-500/20 text-yellow-400 border border-yellow-500/30 rounded">PUT</span>
-                                    <span class="px-2 py-1 text-xs font-mono bg-red-500/20 text-red-400 border border-red-500/30 rounded">DELETE</span>
-                                    <code class="text-sm font-mono text-gray-300 bg-gray-800 px-3 py-1 rounded">/api/v1/books/{id}/</code>
-                                </div>
-                            </div>
-                            <p class="text-gray-400 mb-4">Retrieve, update, or delete a specific book by its unique identifier.</p>
-                            <div class="bg-black/50 border border-gray-800 rounded-lg p-4">
-                                <pre class="text-sm text-gray-300 font-mono">
-<span class="text-green-400">GET</span>: Returns book details
-<span class="text-yellow-400">PUT</span>: Updates book (partial updates supported)
-<span class="text-red-400">DELETE</span>: Removes book from collection</pre>
-                            </div>
-                        </div>
-                    </div>
-
-                    <!-- GET /api/v1/books/recent/ -->
-                    <div class="bg-gray-900/30 border border-gray-800 rounded-xl overflow-hidden hover:border-gray-700 transition-colors">
-                        <div class="p-6">
-                            <div class="flex flex-col md:flex-row md:items-center justify-between mb-4">
-                                <div class="flex items-center space-x-3 mb-3 md:mb-0">
-                                    <span class="px-2 py-1 text-xs font-mono bg-green-500/20 text-green-400 border border-green-500/30 rounded">GET</span>
-                                    <code class="text-sm font-mono text-gray-300 bg-gray-800 px-3 py-1 rounded">/api/v1/books/recent/</code>
-                                </div>
-                            </div>
-                            <p class="text-gray-400 mb-4">Get the most recently added books, sorted by creation date.</p>
-                            <div class="bg-black/50 border border-gray-800 rounded-lg p-4">
-                                <pre class="text-sm text-gray-300 font-mono">Returns: Latest 10 books by default (configurable with ?limit parameter)</pre>
-                            </div>
-                        </div>
-                    </div>
-                </div>
-            </section>
-
+` + m.apiDocsResources() + `
             <!-- Authentication Section -->
             <section>
                 <div class="mb-8">
@@ -490,34 +429,7 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
                 </div>
 
                 <div class="space-y-6">
-                    <!-- Login Endpoint -->
-                    <div class="bg-gray-900/30 border border-gray-800 rounded-xl overflow-hidden hover:border-gray-700 transition-colors">
-                        <div class="p-6">
-                            <div class="flex flex-col md:flex-row md:items-center justify-between mb-4">
-                                <div class="flex items-center space-x-3 mb-3 md:mb-0">
-                                    <span class="px-2 py-1 text-xs font-mono bg-green-500/20 text-green-400 border border-green-500/30 rounded">GET</span>
-                                    <span class="px-2 py-1 text-xs font-mono bg-blue-500/20 text-blue-400 border border-blue-500/30 rounded">POST</span>
-                                    <code class="text-sm font-mono text-gray-300 bg-gray-800 px-3 py-1 rounded">/api-auth/login/</code>
-                                </div>
-                            </div>
-                            <p class="text-gray-400">Authenticate users and obtain session credentials for API access.</p>
-                        </div>
-                    </div>
-
-                    <!-- Logout Endpoint -->
-                    <div class="bg-gray-900/30 border border-gray-800 rounded-xl overflow-hidden hover:border-gray-700 transition-colors">
-                        <div class="p-6">
-                            <div class="flex flex-col md:flex-row md:items-center justify-between mb-4">
-                                <div class="flex items-center space-x-3 mb-3 md:mb-0">
-                                    <span class="px-2 py-1 text-xs font-mono bg-green-500/20 text-green-400 border border-green-500/30 rounded">GET</span>
-                                    <span class="px-2 py-1 text-xs font-mono bg-blue-500/20 text-blue-400 border border-blue-500/30 rounded">POST</span>
-                                    <code class="text-sm font-mono text-gray-300 bg-gray-800 px-3 py-1 rounded">/api-auth/logout/</code>
-                                </div>
-                            </div>
-                            <p class="text-gray-400">Safely terminate user sessions and invalidate authentication credentials.</p>
-                        </div>
-                    </div>
-                </div>
+` + authEndpoints + `                </div>
             </section>
 
             <!-- Quick Start Guide -->
@@ -525,24 +437,7 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
                 <h2 class="text-2xl font-bold text-white mb-6">Quick Start Guide</h2>
 
                 <div class="grid md:grid-cols-2 gap-6">
-                    <div>
-                        <h3 class="text-lg font-semibold text-white mb-3">1. Authentication</h3>
-                        <div class="bg-black/50 border border-gray-700 rounded-lg p-4">
-                            <pre class="text-sm text-gray-300 font-mono overflow-x-auto">
-<span class="text-purple-400">curl</span> <span class="text-blue-400">-X POST</span> http://localhost:8000/api-auth/login/ \
-  <span class="text-blue-400">-d</span> <span class="text-green-400">"username=your_username&password=your_password"</span></pre>
-                        </div>
-                    </div>
-
-                    <div>
-                        <h3 class="text-lg font-semibold text-white mb-3">2. Fetch Books</h3>
-                        <div class="bg-black/50 border border-gray-700 rounded-lg p-4">
-                            <pre class="text-sm text-gray-300 font-mono overflow-x-auto">
-<span class="text-purple-400">curl</span> <span class="text-blue-400">-X GET</span> http://localhost:8000/api/v1/books/ \
-  <span class="text-blue-400">-H</span> <span class="text-green-400">"Authorization: Bearer your_token"</span></pre>
-                        </div>
-                    </div>
-                </div>
+` + quickStart + `                </div>
             </section>
         </div>
 
@@ -566,8 +461,11 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
     </div>
 </div>
 {% endblock %}`
-	if err := os.WriteFile(filepath.Join(globalTemplatesPath, "api-docs.html"), []byte(apiDocsContent), 0644); err != nil {
-		return fmt.Errorf("failed to create api-docs.html: %v", err)
+	// With OpenAPI docs the links go to Swagger UI and ReDoc instead.
+	if !m.hasGeneratedAPIDocs() {
+		if err := os.WriteFile(filepath.Join(globalTemplatesPath, "api-docs.html"), []byte(m.pointAPILinks(apiDocsContent)), 0644); err != nil {
+			return fmt.Errorf("failed to create api-docs.html: %v", err)
+		}
 	}
 
 	m.stepMessages = append(m.stepMessages, "✅ Created global templates and static files.")
@@ -575,6 +473,64 @@ func (m *Model) setupGlobalTemplates(projectPath string) error {
 	return nil
 }
 
+// apiDocsResources renders the endpoints section of the api-docs page: the
+// list, detail and recent endpoints of every app created with the API
+// blueprint.
+func (m *Model) apiDocsResources() string {
+	apps := m.appsWithBlueprint(blueprintAPI)
+	if len(apps) == 0 {
+		return `            <section>
+                <div class="mb-8">
+                    <h2 class="text-3xl font-bold text-white mb-3">Resources</h2>
+                    <p class="text-gray-400">No app uses the API blueprint yet. Add one with <code class="text-sm font-mono text-gray-300 bg-gray-800 px-2 py-1 rounded">django-forge add-app &lt;name&gt;:api</code> and register more viewsets in api.py.</p>
+                </div>
+            </section>
+`
+	}
+
+	var sections []string
+	for _, appName := range apps {
+		modelName := blueprintModelName(appName)
+		path := "/api/v1/" + m.blueprintAPIPrefix(appName) + "/"
+		endpoints := apiDocsEndpoint([]string{"GET", "POST"}, path, fmt.Sprintf("List %s objects or create a new one.", modelName)) +
+			"\n" + apiDocsEndpoint([]string{"GET", "PUT", "PATCH", "DELETE"}, path+"{id}/", fmt.Sprintf("Retrieve, update or delete a %s by its id.", modelName)) +
+			"\n" + apiDocsEndpoint([]string{"GET"}, path+"recent/", fmt.Sprintf("List the %s objects created in the last 30 days.", modelName))
+		sections = append(sections, fmt.Sprintf(`            <section>
+                <div class="mb-8">
+                    <h2 class="text-3xl font-bold text-white mb-3">%s API</h2>
+                    <p class="text-gray-400">Manage the %s objects of the %s app</p>
+                </div>
+
+                <div class="space-y-6">
+%s                </div>
+            </section>
+`, titleWords(appName), modelName, appName, endpoints))
+	}
+	return strings.Join(sections, "\n")
+}
+
+// apiDocsEndpoint renders an endpoint card of the api-docs page.
+func apiDocsEndpoint(methods []string, path, description string) string {
+	colors := map[string]string{"GET": "green", "POST": "blue", "PUT": "yellow", "PATCH": "yellow", "DELETE": "red"}
+	var badges []string
+	for _, method := range methods {
+		color := colors[method]
+		badges = append(badges, fmt.Sprintf(`<span class="px-2 py-1 text-xs font-mono bg-%[1]s-500/20 text-%[1]s-400 border border-%[1]s-500/30 rounded">%[2]s</span>`, color, method))
+	}
+	return fmt.Sprintf(`                    <div class="bg-gray-900/30 border border-gray-800 rounded-xl overflow-hidden hover:border-gray-700 transition-colors">
+                        <div class="p-6">
+                            <div class="flex flex-col md:flex-row md:items-center justify-between mb-4">
+                                <div class="flex items-center space-x-3 mb-3 md:mb-0">
+                                    %s
+                                    <code class="text-sm font-mono text-gray-300 bg-gray-800 px-3 py-1 rounded">%s</code>
+                                </div>
+                            </div>
+                            <p class="text-gray-400">%s</p>
+                        </div>
+                    </div>
+`, strings.Join(badges, "\n                                    "), path, description)
+}
+
 func updateSettingsForTemplates(settingsContent string) string {
 	templatesDirsSetting := "'DIRS': [BASE_DIR / 'templates']"
 	if !strings.Contains(settingsContent, templatesDirsSetting) {
@@ -601,8 +557,13 @@ func updateSettingsForTemplates(settingsContent string) string {
 }
 
 func (m *Model) setupProjectUrls(projectPath string) error {
+	if m.apiOnly {
+		// Nothing is rendered as HTML, so there is no home view or context processor.
+		return m.writeProjectUrls(projectPath)
+	}
+
 	// First create a context processor to make project_name available globally
-	contextProcessorsPath := filepath.Join(projectPath, m.projectName, "context_processors.py")
+	contextProcessorsPath := filepath.Join(projectPath, m.packageName, "context_processors.py")
 	contextProcessorsContent := fmt.Sprintf(`def project_context(request):
     return {
         'project_name': '%s'
@@ -614,7 +575,7 @@ func (m *Model) setupProjectUrls(projectPath string) error {
 	}
 
 	// Update settings.py to include the context processor
-	settingsPath := filepath.Join(projectPath, m.projectName, "settings.py")
+	settingsPath := filepath.Join(projectPath, m.packageName, "settings.py")
 	settingsContent, err := os.ReadFile(settingsPath)
 	if err != nil {
 		return fmt.Errorf("failed to read settings.py: %v", err)
@@ -624,7 +585,7 @@ func (m *Model) setupProjectUrls(projectPath string) error {
 	updatedSettings := strings.Replace(
 		string(settingsContent),
 		"'django.contrib.messages.context_processors.messages',",
-		"'django.contrib.messages.context_processors.messages',\n                '"+m.projectName+".context_processors.project_context',",
+		"'django.contrib.messages.context_processors.messages',\n                '"+m.packageName+".context_processors.project_context',",
 		1,
 	)
 
@@ -638,27 +599,10 @@ func (m *Model) setupProjectUrls(projectPath string) error {
 class HomeView(TemplateView):
     template_name = 'index.html'`
 
-	viewsPath := filepath.Join(projectPath, m.projectName, "views.py")
+	viewsPath := filepath.Join(projectPath, m.packageName, "views.py")
 	if err := os.WriteFile(viewsPath, []byte(viewsContent), 0644); err != nil {
 		return fmt.Errorf("failed to create views.py: %v", err)
 	}
 
-	// Update urls.py
-	urlsContent := fmt.Sprintf(`from django.contrib import admin
-from django.urls import path, include
-from . import views
-
-urlpatterns = [
-    path('', views.HomeView.as_view(), name='home'),
-    path('admin/', admin.site.urls),
-    path('api-docs/', views.HomeView.as_view(template_name='api-docs.html'), name='api_docs'),
-    path('__reload__/', include('django_browser_reload.urls')),
-]`)
-
-	urlsPath := filepath.Join(projectPath, m.projectName, "urls.py")
-	if err := os.WriteFile(urlsPath, []byte(urlsContent), 0644); err != nil {
-		return fmt.Errorf("failed to create urls.py: %v", err)
-	}
-
-	return nil
+	return m.writeProjectUrls(projectPath)
 }
@@ -0,0 +1,617 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// defaultClientDir is where generate client writes the TypeScript client.
+const defaultClientDir = "frontend/src/api"
+
+// openAPIDocument is the part of an OpenAPI 3.0 or 3.1 document the client
+// generator reads.
+type openAPIDocument struct {
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas map[string]*openAPISchema `json:"schemas"`
+	} `json:"components"`
+}
+
+type openAPIOperation struct {
+	OperationID string             `json:"operationId"`
+	Parameters  []openAPIParameter `json:"parameters"`
+	RequestBody *struct {
+		Required bool                        `json:"required"`
+		Content  map[string]openAPIMediaType `json:"content"`
+	} `json:"requestBody"`
+	Responses map[string]struct {
+		Content map[string]openAPIMediaType `json:"content"`
+	} `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required"`
+	Schema   *openAPISchema `json:"schema"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref"`
+	Type                 openAPITypes              `json:"type"`
+	Format               string                    `json:"format"`
+	Enum                 []interface{}             `json:"enum"`
+	Nullable             bool                      `json:"nullable"`
+	ReadOnly             bool                      `json:"readOnly"`
+	Items                *openAPISchema            `json:"items"`
+	Properties           map[string]*openAPISchema `json:"properties"`
+	Required             []string                  `json:"required"`
+	AdditionalProperties json.RawMessage           `json:"additionalProperties"`
+	AllOf                []*openAPISchema          `json:"allOf"`
+	OneOf                []*openAPISchema          `json:"oneOf"`
+	AnyOf                []*openAPISchema          `json:"anyOf"`
+}
+
+// openAPITypes holds "type", which OpenAPI 3.1 allows to be a list such as
+// ["string", "null"].
+type openAPITypes []string
+
+func (t *openAPITypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = openAPITypes{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*t = list
+	return nil
+}
+
+// clientMethods are the HTTP methods the client covers, in output order.
+var clientMethods = []string{"get", "post", "put", "patch", "delete"}
+
+var nonIdentifierChars = regexp.MustCompile(`[^A-Za-z0-9_$]+`)
+
+func runGenerateClient(args []string) error {
+	fs := flag.NewFlagSet("generate client", flag.ContinueOnError)
+	outDir := fs.String("out", defaultClientDir, "Directory to write types.ts and client.ts to, relative to the project")
+	schemaFile := fs.String("schema", "", "Read this OpenAPI JSON document instead of generating one")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return fmt.Errorf("usage: django-forge generate client [--out dir] [--schema openapi.json]")
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %v", err)
+	}
+	project, err := findProject(wd)
+	if err != nil {
+		return err
+	}
+	m := project.model()
+
+	var schemaContent []byte
+	if *schemaFile != "" {
+		schemaContent, err = os.ReadFile(*schemaFile)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %v", *schemaFile, err)
+		}
+	} else {
+		if _, err := os.Stat(project.apiPath()); err != nil {
+			return fmt.Errorf("generate client needs a project created with an API (%s not found)", project.apiPath())
+		}
+		schemaContent, err = project.exportAPISchema(m)
+		if err != nil {
+			return err
+		}
+	}
+	var document openAPIDocument
+	if err := json.Unmarshal(schemaContent, &document); err != nil {
+		return fmt.Errorf("failed to parse the OpenAPI document: %v", err)
+	}
+
+	dir := *outDir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(project.root, dir)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %v", dir, err)
+	}
+	types, client, count, err := document.typeScriptClient()
+	if err != nil {
+		return err
+	}
+	for name, content := range map[string]string{"types.ts": types, "client.ts": client} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %v", name, err)
+		}
+	}
+	m.stepMessages = append(m.stepMessages, fmt.Sprintf("✅ Wrote %d endpoint function(s) to %s and their types to %s.", count, filepath.Join(dir, "client.ts"), filepath.Join(dir, "types.ts")))
+	m.stepMessages = append(m.stepMessages, "💡 Rerun 'django-forge generate client' after changing the API.")
+	printStepMessages(m.stepMessages)
+	return nil
+}
+
+// exportAPISchema runs the project's schema generator through its
+// virtual environment and returns the OpenAPI document as JSON: the
+// NinjaAPI's own schema, or drf-spectacular's for REST Framework, whose
+// built-in generator is deprecated and needs packages projects don't have.
+func (p *existingProject) exportAPISchema(m *Model) ([]byte, error) {
+	tempDir, err := os.MkdirTemp("", "django-forge-schema")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+	schemaPath := filepath.Join(tempDir, "openapi.json")
+
+	var args []string
+	settingsContent, _ := os.ReadFile(p.settingsPath)
+	switch {
+	case m.apiFramework == apiFrameworkNinja:
+		script := fmt.Sprintf("import json; from %s.api import api; open(%q, 'w').write(json.dumps(api.get_openapi_schema()))", p.packageName, schemaPath)
+		args = []string{"manage.py", "shell", "-c", script}
+	case strings.Contains(string(settingsContent), "'drf_spectacular'"):
+		args = []string{"manage.py", "spectacular", "--format", "openapi-json", "--file", schemaPath}
+	default:
+		return nil, fmt.Errorf("generate client needs drf-spectacular to export the REST Framework schema, and %s has no 'drf_spectacular' in INSTALLED_APPS; create projects with --openapi, or pass an OpenAPI document with --schema", p.settingsPath)
+	}
+
+	cmd := exec.Command(getPythonPath(p.root), args...)
+	cmd.Dir = p.root
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("failed to generate the API schema with %s: %v\nOutput: %s", args[1], err, string(output))
+	}
+	schemaContent, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the generated API schema: %v", err)
+	}
+	return schemaContent, nil
+}
+
+// typeScriptClient renders types.ts, with an interface per component schema,
+// and client.ts, with a fetch function per operation. It also returns the
+// number of functions, and fails on a path or operation it cannot decode.
+func (d *openAPIDocument) typeScriptClient() (types, client string, count int, err error) {
+	var t strings.Builder
+	t.WriteString(generatedTSHeader)
+	names := make([]string, 0, len(d.Components.Schemas))
+	for name := range d.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	inputTypes := map[string]string{}
+	for _, name := range names {
+		schema := d.Components.Schemas[name]
+		t.WriteString("\n" + tsDeclaration(tsTypeName(name), schema))
+		if readOnly := schema.readOnlyProperties(); len(readOnly) > 0 {
+			inputTypes[name] = tsTypeName(name) + "Input"
+			fmt.Fprintf(&t, "\n/** %s without its read-only fields, as sent in request bodies. */\nexport type %s = Omit<%s, %s>;\n",
+				tsTypeName(name), inputTypes[name], tsTypeName(name), strings.Join(readOnly, " | "))
+		}
+	}
+
+	var functions []string
+	usedTypes := map[string]bool{}
+	usedNames := map[string]bool{}
+	paths := make([]string, 0, len(d.Paths))
+	for path := range d.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		var pathParameters []openAPIParameter
+		if raw, ok := d.Paths[path]["parameters"]; ok {
+			if err := json.Unmarshal(raw, &pathParameters); err != nil {
+				return "", "", 0, fmt.Errorf("failed to parse the parameters of %s: %v", path, err)
+			}
+		}
+		for _, method := range clientMethods {
+			raw, ok := d.Paths[path][method]
+			if !ok {
+				continue
+			}
+			var operation openAPIOperation
+			if err := json.Unmarshal(raw, &operation); err != nil {
+				return "", "", 0, fmt.Errorf("failed to parse %s %s: %v", strings.ToUpper(method), path, err)
+			}
+			operation.inheritParameters(pathParameters)
+			name := operation.functionName(method, path)
+			for usedNames[name] {
+				name += "_"
+			}
+			usedNames[name] = true
+			functions = append(functions, operation.tsFunction(name, method, path, inputTypes, usedTypes))
+		}
+	}
+
+	var c strings.Builder
+	c.WriteString(generatedTSHeader)
+	if len(usedTypes) > 0 {
+		imported := make([]string, 0, len(usedTypes))
+		for name := range usedTypes {
+			imported = append(imported, name)
+		}
+		sort.Strings(imported)
+		fmt.Fprintf(&c, "\nimport type { %s } from './types';\n", strings.Join(imported, ", "))
+	}
+	c.WriteString(tsClientRuntime)
+	for _, function := range functions {
+		c.WriteString("\n" + function)
+	}
+	return t.String(), c.String(), len(functions), nil
+}
+
+const generatedTSHeader = `// Generated by django-forge from the project's OpenAPI schema. Do not edit:
+// rerun 'django-forge generate client' after changing the API.
+`
+
+// tsClientRuntime is the request helper every endpoint function calls.
+const tsClientRuntime = `
+export interface ClientOptions {
+  /** Prepended to every path, e.g. 'http://localhost:8000' for a separate dev server. */
+  baseUrl?: string;
+  /** Extra headers, e.g. { Authorization: 'Bearer ...' }. */
+  headers?: Record<string, string>;
+  credentials?: RequestCredentials;
+  fetch?: typeof fetch;
+}
+
+let options: ClientOptions = {};
+
+export function configureClient(next: ClientOptions): void {
+  options = { ...options, ...next };
+}
+
+export class ApiError extends Error {
+  constructor(
+    public status: number,
+    public body: unknown,
+  ) {
+    super(` + "`API request failed with status ${status}`" + `);
+  }
+}
+
+function csrfToken(): string | undefined {
+  if (typeof document === 'undefined') {
+    return undefined;
+  }
+  const cookie = document.cookie.split('; ').find((c) => c.startsWith('csrftoken='));
+  return cookie ? decodeURIComponent(cookie.split('=')[1]) : undefined;
+}
+
+async function request<T>(
+  method: string,
+  path: string,
+  init: { query?: Record<string, unknown>; body?: unknown } = {},
+): Promise<T> {
+  const query = new URLSearchParams();
+  for (const [key, value] of Object.entries(init.query ?? {})) {
+    if (value !== undefined && value !== null) {
+      query.append(key, String(value));
+    }
+  }
+  const search = query.toString();
+  const headers: Record<string, string> = { Accept: 'application/json' };
+  if (init.body !== undefined) {
+    headers['Content-Type'] = 'application/json';
+  }
+  const token = method === 'GET' ? undefined : csrfToken();
+  if (token) {
+    headers['X-CSRFToken'] = token;
+  }
+  const response = await (options.fetch ?? fetch)((options.baseUrl ?? '') + path + (search ? '?' + search : ''), {
+    method,
+    headers: { ...headers, ...options.headers },
+    credentials: options.credentials ?? 'same-origin',
+    body: init.body === undefined ? undefined : JSON.stringify(init.body),
+  });
+  if (!response.ok) {
+    throw new ApiError(response.status, await response.json().catch(() => undefined));
+  }
+  if (response.status === 204) {
+    return undefined as T;
+  }
+  return (await response.json()) as T;
+}
+`
+
+// inheritParameters adds the parameters declared for the whole path that the
+// operation doesn't override; a parameter is identified by its name and
+// location.
+func (o *openAPIOperation) inheritParameters(pathParameters []openAPIParameter) {
+	var inherited []openAPIParameter
+	for _, param := range pathParameters {
+		overridden := false
+		for _, own := range o.Parameters {
+			if own.Name == param.Name && own.In == param.In {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			inherited = append(inherited, param)
+		}
+	}
+	o.Parameters = append(inherited, o.Parameters...)
+}
+
+// functionName is the camelCased operationId, or one built from the method
+// and path when the schema has none.
+func (o *openAPIOperation) functionName(method, path string) string {
+	source := o.OperationID
+	if source == "" {
+		source = method + "_" + path
+	}
+	parts := nonIdentifierChars.Split(strings.ReplaceAll(source, "_", " "), -1)
+	var words []string
+	for _, part := range parts {
+		words = append(words, strings.Fields(part)...)
+	}
+	var b strings.Builder
+	for i, word := range words {
+		if i == 0 {
+			b.WriteString(strings.ToLower(word[:1]) + word[1:])
+		} else {
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	name := b.String()
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "call" + titleWords(name)
+	}
+	return name
+}
+
+// tsFunction renders the endpoint function: path parameters become
+// arguments, query parameters an optional object and the request body a
+// typed argument.
+func (o *openAPIOperation) tsFunction(name, method, path string, inputTypes map[string]string, usedTypes map[string]bool) string {
+	var args, queryFields []string
+	urlPath := path
+	for _, param := range o.Parameters {
+		paramType := tsType(param.Schema, usedTypes)
+		switch param.In {
+		case "path":
+			arg := tsIdentifier(param.Name)
+			args = append(args, arg+": "+paramType)
+			urlPath = strings.ReplaceAll(urlPath, "{"+param.Name+"}", "${encodeURIComponent(String("+arg+"))}")
+		case "query":
+			queryFields = append(queryFields, fmt.Sprintf("%s%s: %s", tsPropertyName(param.Name), Ternary(param.Required, "", "?"), paramType))
+		}
+	}
+
+	var options []string
+	if body := o.requestBodySchema(); body != nil {
+		var bodyType string
+		if input, ok := inputTypes[refName(body.Ref)]; ok && body.Ref != "" {
+			usedTypes[input] = true
+			bodyType = input
+		} else {
+			bodyType = tsType(body, usedTypes)
+		}
+		args = append(args, "body: "+bodyType)
+		options = append(options, "body")
+	}
+	if len(queryFields) > 0 {
+		args = append(args, "query: { "+strings.Join(queryFields, "; ")+" } = {}")
+		options = append(options, "query")
+	}
+
+	responseType := "void"
+	if schema := o.responseSchema(); schema != nil {
+		responseType = tsType(schema, usedTypes)
+	}
+
+	call := fmt.Sprintf("request('%s', `%s`", strings.ToUpper(method), urlPath)
+	if len(options) > 0 {
+		call += ", { " + strings.Join(options, ", ") + " }"
+	}
+	return fmt.Sprintf("export function %s(%s): Promise<%s> {\n  return %s);\n}\n", name, strings.Join(args, ", "), responseType, call)
+}
+
+func (o *openAPIOperation) requestBodySchema() *openAPISchema {
+	if o.RequestBody == nil {
+		return nil
+	}
+	return jsonSchema(o.RequestBody.Content)
+}
+
+// responseSchema returns the schema of the first successful response.
+func (o *openAPIOperation) responseSchema() *openAPISchema {
+	codes := make([]string, 0, len(o.Responses))
+	for code := range o.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		if strings.HasPrefix(code, "2") {
+			return jsonSchema(o.Responses[code].Content)
+		}
+	}
+	return nil
+}
+
+// jsonSchema picks the JSON body of a content map, or the only one there is.
+func jsonSchema(content map[string]openAPIMediaType) *openAPISchema {
+	if media, ok := content["application/json"]; ok {
+		return media.Schema
+	}
+	for _, media := range content {
+		if len(content) == 1 {
+			return media.Schema
+		}
+	}
+	return nil
+}
+
+func (s *openAPISchema) readOnlyProperties() []string {
+	var names []string
+	for name, property := range s.Properties {
+		if property.ReadOnly {
+			names = append(names, tsString(name))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// tsDeclaration renders a component schema as an interface, or as a type
+// alias when it is not an object.
+func tsDeclaration(name string, schema *openAPISchema) string {
+	if len(schema.Properties) == 0 || len(schema.AllOf)+len(schema.OneOf)+len(schema.AnyOf) > 0 {
+		return fmt.Sprintf("export type %s = %s;\n", name, tsType(schema, nil))
+	}
+	return fmt.Sprintf("export interface %s %s\n", name, tsObjectType(schema, nil, ""))
+}
+
+func tsObjectType(schema *openAPISchema, usedTypes map[string]bool, indent string) string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, name := range names {
+		property := schema.Properties[name]
+		optional := Ternary(contains(schema.Required, name), "", "?")
+		readOnly := Ternary(property.ReadOnly, "readonly ", "")
+		fmt.Fprintf(&b, "%s  %s%s%s: %s;\n", indent, readOnly, tsPropertyName(name), optional, tsType(property, usedTypes))
+	}
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+// tsType renders a schema as a TypeScript type, recording the component
+// types it refers to in usedTypes when that is not nil.
+func tsType(schema *openAPISchema, usedTypes map[string]bool) string {
+	if schema == nil {
+		return "unknown"
+	}
+	var result string
+	switch {
+	case schema.Ref != "":
+		result = tsTypeName(refName(schema.Ref))
+		if usedTypes != nil {
+			usedTypes[result] = true
+		}
+	case len(schema.AllOf) > 0:
+		result = tsCombined(schema.AllOf, " & ", usedTypes)
+	case len(schema.OneOf) > 0:
+		result = tsCombined(schema.OneOf, " | ", usedTypes)
+	case len(schema.AnyOf) > 0:
+		result = tsCombined(schema.AnyOf, " | ", usedTypes)
+	case len(schema.Enum) > 0:
+		var values []string
+		for _, value := range schema.Enum {
+			encoded, _ := json.Marshal(value)
+			values = append(values, string(encoded))
+		}
+		result = strings.Join(values, " | ")
+	default:
+		var types []string
+		for _, t := range schema.Type {
+			types = append(types, tsPrimitive(t, schema, usedTypes))
+		}
+		if len(types) == 0 {
+			types = append(types, tsPrimitive("", schema, usedTypes))
+		}
+		result = strings.Join(types, " | ")
+	}
+	if schema.Nullable && !strings.HasSuffix(result, "| null") {
+		result += " | null"
+	}
+	return result
+}
+
+func tsPrimitive(openAPIType string, schema *openAPISchema, usedTypes map[string]bool) string {
+	switch openAPIType {
+	case "string":
+		if schema.Format == "binary" {
+			return "Blob"
+		}
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "null":
+		return "null"
+	case "array":
+		item := tsType(schema.Items, usedTypes)
+		if strings.ContainsAny(item, "|&") {
+			item = "(" + item + ")"
+		}
+		return item + "[]"
+	case "object", "":
+		if len(schema.Properties) > 0 {
+			return tsObjectType(schema, usedTypes, "")
+		}
+		var additional openAPISchema
+		if len(schema.AdditionalProperties) > 0 && json.Unmarshal(schema.AdditionalProperties, &additional) == nil {
+			return "Record<string, " + tsType(&additional, usedTypes) + ">"
+		}
+		if openAPIType == "object" {
+			return "Record<string, unknown>"
+		}
+		return "unknown"
+	}
+	return "unknown"
+}
+
+func tsCombined(schemas []*openAPISchema, separator string, usedTypes map[string]bool) string {
+	var types []string
+	for _, schema := range schemas {
+		types = append(types, tsType(schema, usedTypes))
+	}
+	return strings.Join(types, separator)
+}
+
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// tsTypeName turns a component name into a TypeScript type name.
+func tsTypeName(name string) string {
+	return nonIdentifierChars.ReplaceAllString(name, "")
+}
+
+func tsIdentifier(name string) string {
+	identifier := nonIdentifierChars.ReplaceAllString(name, "_")
+	if identifier == "" || (identifier[0] >= '0' && identifier[0] <= '9') {
+		identifier = "_" + identifier
+	}
+	return identifier
+}
+
+// tsPropertyName quotes property names that are not identifiers.
+func tsPropertyName(name string) string {
+	if tsIdentifier(name) == name {
+		return name
+	}
+	return tsString(name)
+}
+
+// tsString renders a string literal. JSON's escaping is valid TypeScript.
+func tsString(value string) string {
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTSType(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		want     string
+		usedType string
+	}{
+		{name: "ref", schema: `{"$ref": "#/components/schemas/Post"}`, want: "Post", usedType: "Post"},
+		{name: "ref with a non-identifier name", schema: `{"$ref": "#/components/schemas/Post-Input"}`, want: "PostInput", usedType: "PostInput"},
+		{name: "string", schema: `{"type": "string"}`, want: "string"},
+		{name: "binary", schema: `{"type": "string", "format": "binary"}`, want: "Blob"},
+		{name: "integer", schema: `{"type": "integer"}`, want: "number"},
+		{name: "nullable", schema: `{"type": "string", "nullable": true}`, want: "string | null"},
+		{name: "3.1 null type", schema: `{"type": ["integer", "null"]}`, want: "number | null"},
+		{name: "nullable 3.1 null type", schema: `{"type": ["integer", "null"], "nullable": true}`, want: "number | null"},
+		{name: "enum", schema: `{"type": "string", "enum": ["draft", "published"]}`, want: `"draft" | "published"`},
+		{name: "enum with quotes", schema: `{"enum": ["it's", "say \"hi\""]}`, want: `"it's" | "say \"hi\""`},
+		{name: "numeric enum", schema: `{"type": "integer", "enum": [1, 2]}`, want: "1 | 2"},
+		{name: "nullable enum", schema: `{"enum": ["a", null], "nullable": true}`, want: `"a" | null`},
+		{name: "array of refs", schema: `{"type": "array", "items": {"$ref": "#/components/schemas/Tag"}}`, want: "Tag[]", usedType: "Tag"},
+		{name: "array of a union", schema: `{"type": "array", "items": {"type": ["string", "null"]}}`, want: "(string | null)[]"},
+		{name: "map", schema: `{"type": "object", "additionalProperties": {"type": "integer"}}`, want: "Record<string, number>"},
+		{name: "free-form object", schema: `{"type": "object"}`, want: "Record<string, unknown>"},
+		{name: "allOf", schema: `{"allOf": [{"$ref": "#/components/schemas/Base"}, {"$ref": "#/components/schemas/Extra"}]}`, want: "Base & Extra", usedType: "Base"},
+		{name: "oneOf", schema: `{"oneOf": [{"type": "string"}, {"type": "integer"}]}`, want: "string | number"},
+		{name: "missing", schema: `null`, want: "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var schema *openAPISchema
+			if err := json.Unmarshal([]byte(tt.schema), &schema); err != nil {
+				t.Fatal(err)
+			}
+			usedTypes := map[string]bool{}
+			if got := tsType(schema, usedTypes); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+			if tt.usedType != "" && !usedTypes[tt.usedType] {
+				t.Errorf("%s was not recorded as used: %v", tt.usedType, usedTypes)
+			}
+		})
+	}
+}
+
+func TestTSObjectType(t *testing.T) {
+	var schema *openAPISchema
+	if err := json.Unmarshal([]byte(`{"type": "object", "required": ["id"], "properties": {"id": {"type": "integer", "readOnly": true}, "first-name": {"type": "string"}}}`), &schema); err != nil {
+		t.Fatal(err)
+	}
+	got := tsType(schema, nil)
+	for _, want := range []string{"readonly id: number;", `"first-name"?: string;`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("%q not found in:\n%s", want, got)
+		}
+	}
+}
+
+func TestFunctionName(t *testing.T) {
+	tests := []struct {
+		operationID string
+		method      string
+		path        string
+		want        string
+	}{
+		{operationID: "posts_list", method: "get", path: "/api/v1/posts/", want: "postsList"},
+		{operationID: "blog_api_create_post", method: "post", path: "/api/v1/blog/", want: "blogApiCreatePost"},
+		{operationID: "Posts-Partial Update", method: "patch", path: "/api/v1/posts/{id}/", want: "postsPartialUpdate"},
+		{operationID: "2fa_verify", method: "post", path: "/api/v1/2fa/", want: "call2faVerify"},
+		{method: "get", path: "/api/v1/posts/{id}/", want: "getApiV1PostsId"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			operation := &openAPIOperation{OperationID: tt.operationID}
+			if got := operation.functionName(tt.method, tt.path); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInheritParameters(t *testing.T) {
+	id := openAPIParameter{Name: "id", In: "path", Required: true}
+	version := openAPIParameter{Name: "version", In: "header"}
+	search := openAPIParameter{Name: "search", In: "query"}
+	tests := []struct {
+		name string
+		path []openAPIParameter
+		own  []openAPIParameter
+		want []openAPIParameter
+	}{
+		{name: "no path parameters", own: []openAPIParameter{search}, want: []openAPIParameter{search}},
+		{name: "path parameters first", path: []openAPIParameter{id}, own: []openAPIParameter{search}, want: []openAPIParameter{id, search}},
+		{
+			name: "overridden by name and location",
+			path: []openAPIParameter{id, version},
+			own:  []openAPIParameter{{Name: "id", In: "path", Required: true, Schema: &openAPISchema{Type: openAPITypes{"integer"}}}},
+			want: []openAPIParameter{version, {Name: "id", In: "path", Required: true, Schema: &openAPISchema{Type: openAPITypes{"integer"}}}},
+		},
+		{
+			name: "same name elsewhere",
+			path: []openAPIParameter{id},
+			own:  []openAPIParameter{{Name: "id", In: "query"}},
+			want: []openAPIParameter{id, {Name: "id", In: "query"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := &openAPIOperation{Parameters: tt.own}
+			operation.inheritParameters(tt.path)
+			if !reflect.DeepEqual(operation.Parameters, tt.want) {
+				t.Errorf("got %+v, want %+v", operation.Parameters, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypeScriptClientPathParameters(t *testing.T) {
+	var document openAPIDocument
+	err := json.Unmarshal([]byte(`{
+		"paths": {
+			"/api/v1/posts/{id}/": {
+				"parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "integer"}}],
+				"get": {"operationId": "posts_retrieve", "responses": {"200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Post"}}}}}}
+			}
+		},
+		"components": {"schemas": {"Post": {"type": "object", "properties": {"title": {"type": "string"}}}}}
+	}`), &document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types, client, count, err := document.typeScriptClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected one function, got %d", count)
+	}
+	if !strings.Contains(types, "export interface Post") {
+		t.Errorf("Post interface not found in:\n%s", types)
+	}
+	for _, want := range []string{"import type { Post } from './types';", "export function postsRetrieve(id: number)", "Promise<Post>"} {
+		if !strings.Contains(client, want) {
+			t.Errorf("%q not found in:\n%s", want, client)
+		}
+	}
+}
+
+func TestTypeScriptClientInvalidOperation(t *testing.T) {
+	tests := []struct {
+		name     string
+		pathItem string
+		want     string
+	}{
+		{name: "operation", pathItem: `{"get": {"parameters": "id"}}`, want: "GET /api/v1/posts/"},
+		{name: "path parameters", pathItem: `{"parameters": {"name": "id"}}`, want: "/api/v1/posts/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var document openAPIDocument
+			if err := json.Unmarshal([]byte(`{"paths": {"/api/v1/posts/": `+tt.pathItem+`}}`), &document); err != nil {
+				t.Fatal(err)
+			}
+			_, _, _, err := document.typeScriptClient()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected an error naming %s, got %v", tt.want, err)
+			}
+		})
+	}
+}
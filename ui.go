@@ -2,7 +2,6 @@ package main
 
 import (
 	"fmt"
-	"path/filepath"
 	"strings"
 
 	"github.com/charmbracelet/huh"
@@ -60,8 +59,43 @@ var (
 			Light: "#059669",
 			Dark:  "#10B981",
 		})
+
+	warningStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.AdaptiveColor{
+			Light: "#D97706",
+			Dark:  "#FBBF24",
+		})
+
+	resultsBox = lipgloss.NewStyle().
+			Padding(0, 1).
+			MarginBottom(1).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.AdaptiveColor{
+			Light: "#D97706",
+			Dark:  "#FBBF24",
+		})
 )
 
+func (m *Model) deployCheckView(width int) string {
+	if !m.deployCheckRan {
+		return ""
+	}
+
+	var s strings.Builder
+	s.WriteString(subtitleStyle.Render("🔒 Deployment Check (settings_production)") + "\n")
+	if len(m.deployIssues) == 0 {
+		s.WriteString(progressStyle.Render("No issues found by manage.py check --deploy."))
+		return resultsBox.Width(width).Render(s.String()) + "\n"
+	}
+	for _, issue := range m.deployIssues {
+		s.WriteString(warningStyle.Render(fmt.Sprintf("%s %s", issue.level, issue.id)) + " " + issue.message + "\n")
+		if issue.hint != "" {
+			s.WriteString(footerStyle.UnsetMarginTop().Render("  Hint: "+issue.hint) + "\n")
+		}
+	}
+	return resultsBox.Width(width).Render(strings.TrimRight(s.String(), "\n")) + "\n"
+}
+
 func (m *Model) View() string {
 	viewWidth := m.width
 	if viewWidth <= 0 {
@@ -101,10 +135,11 @@ func (m *Model) View() string {
 			s.WriteString("   ╰─────╯\n")
 		} else {
 			s.WriteString(titleStyle.Render("✅ Django Project Setup Complete!") + "\n\n")
+			s.WriteString(m.deployCheckView(contentWidth - 8))
 			s.WriteString(subtitleStyle.Render("What's Next:") + "\n")
-			s.WriteString(fmt.Sprintf("1. Navigate to your project directory:\n   cd %s\n\n", m.projectName))
+			projectAbsPath, _ := m.projectDir()
+			s.WriteString(fmt.Sprintf("1. Navigate to your project directory:\n   cd %s\n\n", projectAbsPath))
 
-			projectAbsPath, _ := filepath.Abs(m.projectName)
 			pythonVenvPath := getPythonPath(projectAbsPath)
 			s.WriteString(fmt.Sprintf("2. Start the development server:\n   %s manage.py runserver\n\n", pythonVenvPath))
 		}
@@ -189,6 +224,7 @@ func (m *Model) View() string {
 	case stepDevServerPrompt:
 		if activeForm != nil {
 			s.WriteString(titleStyle.Render("🎉 Project Setup Complete!") + "\n\n")
+			s.WriteString(m.deployCheckView(contentWidth - 8))
 			s.WriteString(activeForm.View())
 		}
 
@@ -197,9 +233,10 @@ func (m *Model) View() string {
 		s.WriteString("   ╭─────╮\n")
 		s.WriteString("   │ ◠ ◡ ◠           happy coding\n")
 		s.WriteString("   ╰─────╯\n\n")
+		s.WriteString(m.deployCheckView(contentWidth - 8))
 		s.WriteString(subtitleStyle.Render("Manual Steps:") + "\n")
-		s.WriteString(fmt.Sprintf("1. Navigate to your project: cd %s\n", m.projectName))
-		projectAbsPath, _ := filepath.Abs(m.projectName)
+		projectAbsPath, _ := m.projectDir()
+		s.WriteString(fmt.Sprintf("1. Navigate to your project: cd %s\n", projectAbsPath))
 		pythonVenvPath := getPythonPath(projectAbsPath)
 		if m.setupTailwind {
 			s.WriteString("2. Start CSS watching: npm run watch:css\n")
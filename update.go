@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/progress"
@@ -157,18 +158,93 @@ func (m *Model) processFormData() {
 		m.djangoVersion = "latest"
 	}
 	m.createTemplates = contains(m.selectedOptions, "Global Templates")
-	m.createAppTemplates = contains(m.selectedOptions, "App Templates")
 	m.initializeGit = contains(m.selectedOptions, "Initialize Git")
 	m.setupTailwind = contains(m.selectedOptions, "Tailwind")
-	m.setupRestFramework = contains(m.selectedOptions, "REST Framework")
+	m.setupAPI = contains(m.selectedOptions, "REST API")
+	m.setupCustomUser = contains(m.selectedOptions, "Custom User")
+	m.setupProduction = contains(m.selectedOptions, "Production")
+	m.setupI18n = contains(m.selectedOptions, "Internationalization")
+	m.setupMedia = contains(m.selectedOptions, "Media")
+	m.packageName = m.resolvePackageName()
 	m.stepMessages = append(m.stepMessages, "Project name: "+m.projectName)
+	if m.packageName != m.projectName {
+		m.stepMessages = append(m.stepMessages, "Package name: "+m.packageName)
+	}
 	m.stepMessages = append(m.stepMessages, "Django version: "+m.djangoVersion)
-	if m.appName != "" {
-		m.stepMessages = append(m.stepMessages, "App name: "+m.appName)
+	m.apiOnly = contains(m.selectedOptions, "API Only")
+	m.setupOpenAPI = contains(m.selectedOptions, "OpenAPI Docs")
+	m.apiExtras = contains(m.selectedOptions, "API Extras")
+	m.setupCORS = contains(m.selectedOptions, "CORS")
+	m.setupGraphQL = contains(m.selectedOptions, "GraphQL")
+	m.setApps(m.appNamesInput)
+	m.sampleRows, _ = parseSampleRows(m.sampleRowsInput)
+	m.applyAPIOnly()
+	if m.setupOpenAPI || m.apiExtras {
+		m.setupAPI = true
+	}
+	if m.setupAPI {
+		m.applyAPIFramework()
+		m.stepMessages = append(m.stepMessages, "API framework: "+m.apiFramework)
+	}
+	if len(m.appNames) > 0 {
+		var apps []string
+		for _, appName := range m.appNames {
+			apps = append(apps, fmt.Sprintf("%s (%s)", appName, m.blueprintFor(appName)))
+		}
+		m.stepMessages = append(m.stepMessages, "Apps: "+strings.Join(apps, ", "))
+		if m.nestedApps {
+			m.stepMessages = append(m.stepMessages, "App layout: apps/<name>")
+		}
+		if m.sampleRows > 0 {
+			m.stepMessages = append(m.stepMessages, fmt.Sprintf("Fake rows per model: %d", m.sampleRows))
+		}
+	}
+	if m.superuserName != "" {
+		m.stepMessages = append(m.stepMessages, "Admin user: "+m.superuserName)
+	}
+	if m.setupI18n {
+		m.stepMessages = append(m.stepMessages, "Languages: "+strings.Join(m.projectLanguages(), ", "))
 	}
 	m.stepMessages = append(m.stepMessages, fmt.Sprintf("Selected options: %v", m.selectedOptions))
 }
 
+// setApps parses the comma-separated app list from the form, dropping blanks,
+// duplicates and invalid blueprints while keeping the order entered. API apps
+// need the API, so listing one turns it on.
+func (m *Model) setApps(input string) {
+	m.appNames = nil
+	m.appBlueprints = map[string]appBlueprint{}
+	for _, entry := range strings.Split(input, ",") {
+		spec, err := parseAppSpec(entry)
+		if err != nil || spec.name == "" || contains(m.appNames, spec.name) {
+			continue
+		}
+		m.appNames = append(m.appNames, spec.name)
+		if spec.blueprint != "" {
+			m.appBlueprints[spec.name] = spec.blueprint
+		}
+	}
+	if len(m.appsWithBlueprint(blueprintAPI)) > 0 {
+		m.setupAPI = true
+	}
+}
+
+// applyAPIOnly adjusts the selected features for an API-only project: the
+// API without HTML templates or Tailwind, apps defaulting to the api
+// blueprint, and a dedicated api app when none are listed.
+func (m *Model) applyAPIOnly() {
+	if !m.apiOnly {
+		return
+	}
+	m.createTemplates = false
+	m.setupTailwind = false
+	m.setupAPI = true
+	m.defaultBlueprint = blueprintAPI
+	if len(m.appNames) == 0 {
+		m.appNames = []string{apiOnlyApp}
+	}
+}
+
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
 		if s == item {
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// projectUrlsContent renders the project's urls.py from the selected features.
+// Every step that changes routing rewrites the whole file through here, so the
+// result only depends on what has been generated so far.
+func (m *Model) projectUrlsContent(projectPath string) string {
+	imports := []string{
+		"from django.contrib import admin",
+		"from django.urls import path, include",
+	}
+	var localImports, patterns, localizedPatterns []string
+
+	patterns = append(patterns,
+		"path('admin/', admin.site.urls)",
+		"path('__reload__/', include('django_browser_reload.urls'))",
+	)
+
+	if m.setupAPI {
+		apiImports, apiPatterns := m.api().projectURLs()
+		localImports = append(localImports, apiImports...)
+		patterns = append(patterns, apiPatterns...)
+	}
+	if m.setupOpenAPI {
+		imports = append(imports, "from drf_spectacular.views import SpectacularAPIView, SpectacularRedocView, SpectacularSwaggerView")
+		patterns = append(patterns, openAPIURLPatterns...)
+	}
+
+	if m.createTemplates {
+		localImports = append(localImports, "from . import views")
+		localizedPatterns = append(localizedPatterns, "path('', views.HomeView.as_view(), name='home')")
+		if !m.hasGeneratedAPIDocs() {
+			localizedPatterns = append(localizedPatterns,
+				"path('api-docs/', views.HomeView.as_view(template_name='api-docs.html'), name='api_docs')")
+		}
+	}
+
+	// Each app gets its own prefix and namespace; apps without a urls.py
+	// (no app templates and no API) are not mounted.
+	for _, appName := range m.appNames {
+		if _, err := os.Stat(filepath.Join(m.appDir(projectPath, appName), "urls.py")); err == nil {
+			localizedPatterns = append(localizedPatterns, m.appURLPattern(appName))
+		}
+	}
+
+	if m.setupMedia {
+		imports = append(imports,
+			"from django.conf import settings",
+			"from django.conf.urls.static import static",
+		)
+	}
+
+	// The schema module only exists once the GraphQL step has run.
+	if _, err := os.Stat(filepath.Join(projectPath, m.packageName, graphQLSchemaFile)); m.setupGraphQL && err == nil {
+		if !m.setupMedia {
+			imports = append(imports, "from django.conf import settings")
+		}
+		imports = append(imports, "from strawberry.django.views import GraphQLView")
+		localImports = append(localImports, "from .schema import schema")
+		patterns = append(patterns, "path('graphql/', GraphQLView.as_view(schema=schema, graphiql=settings.DEBUG))")
+	}
+
+	for _, p := range patterns {
+		if strings.HasPrefix(p, "re_path(") {
+			imports[1] = "from django.urls import include, path, re_path"
+			break
+		}
+	}
+
+	if m.setupI18n {
+		imports = append(imports, "from django.conf.urls.i18n import i18n_patterns")
+		patterns = append(patterns, "path('i18n/', include('django.conf.urls.i18n'))")
+	} else {
+		patterns = append(localizedPatterns, patterns...)
+		localizedPatterns = nil
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Join(imports, "\n") + "\n")
+	if len(localImports) > 0 {
+		sort.Strings(localImports)
+		b.WriteString("\n" + strings.Join(localImports, "\n") + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString("urlpatterns = [\n")
+	for _, p := range patterns {
+		b.WriteString("    " + p + ",\n")
+	}
+	b.WriteString("]\n")
+	if len(localizedPatterns) > 0 {
+		b.WriteString("\nurlpatterns += i18n_patterns(\n")
+		for _, p := range localizedPatterns {
+			b.WriteString("    " + p + ",\n")
+		}
+		b.WriteString(")\n")
+	}
+	if m.setupMedia {
+		b.WriteString("\nif settings.DEBUG:\n")
+		b.WriteString("    urlpatterns += static(settings.MEDIA_URL, document_root=settings.MEDIA_ROOT)\n")
+	}
+	return b.String()
+}
+
+// appURLPattern mounts the app's URLconf under its name, which is also its
+// namespace.
+func (m *Model) appURLPattern(appName string) string {
+	return fmt.Sprintf("path('%s/', include('%s.urls', namespace='%s'))", appName, m.appModule(appName), appName)
+}
+
+func (m *Model) writeProjectUrls(projectPath string) error {
+	urlsPath := filepath.Join(projectPath, m.packageName, "urls.py")
+	if err := os.WriteFile(urlsPath, []byte(m.projectUrlsContent(projectPath)), 0644); err != nil {
+		return fmt.Errorf("failed to write urls.py: %v", err)
+	}
+	return nil
+}
+
+// addURLPattern inserts pattern into an existing urls.py, inside the
+// i18n_patterns block when the project has one. It is used by commands that
+// extend a project without regenerating its whole URLconf.
+func addURLPattern(urlsContent, pattern string) (string, error) {
+	if strings.Contains(urlsContent, pattern) {
+		return urlsContent, nil
+	}
+
+	start := strings.Index(urlsContent, "i18n_patterns(\n")
+	if start == -1 {
+		start = strings.Index(urlsContent, "urlpatterns = [")
+		if start == -1 {
+			return urlsContent, fmt.Errorf("could not find urlpatterns in urls.py")
+		}
+	}
+	openIndex := start + strings.IndexAny(urlsContent[start:], "([")
+
+	depth := 0
+	closeIndex := -1
+	for i := openIndex; i < len(urlsContent) && closeIndex == -1; i++ {
+		switch urlsContent[i] {
+		case '(', '[':
+			depth++
+		case ')', ']':
+			depth--
+			if depth == 0 {
+				closeIndex = i
+			}
+		}
+	}
+	if closeIndex == -1 {
+		return urlsContent, fmt.Errorf("could not find the end of urlpatterns in urls.py")
+	}
+
+	before := strings.TrimRight(urlsContent[:closeIndex], " \t\n")
+	if !strings.HasSuffix(before, ",") && before[len(before)-1] != urlsContent[openIndex] {
+		before += ","
+	}
+	updated := before + "\n    " + pattern + ",\n" + urlsContent[closeIndex:]
+
+	if strings.Contains(pattern, "include(") && !strings.Contains(updated, "import path, include") {
+		updated = strings.Replace(updated, "from django.urls import path", "from django.urls import path, include", 1)
+	}
+	return updated, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import "testing"
+
+func TestAddURLPattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		urls    string
+		pattern string
+		want    string
+	}{
+		{
+			name:    "plain urlpatterns",
+			urls:    "from django.urls import path\n\nurlpatterns = [\n    path('admin/', admin.site.urls),\n]\n",
+			pattern: "path('blog/', include('blog.urls'))",
+			want:    "from django.urls import path, include\n\nurlpatterns = [\n    path('admin/', admin.site.urls),\n    path('blog/', include('blog.urls')),\n]\n",
+		},
+		{
+			name:    "missing trailing comma",
+			urls:    "urlpatterns = [\n    path('admin/', admin.site.urls)\n]\n",
+			pattern: "path('', views.home)",
+			want:    "urlpatterns = [\n    path('admin/', admin.site.urls),\n    path('', views.home),\n]\n",
+		},
+		{
+			name:    "empty list",
+			urls:    "urlpatterns = []\n",
+			pattern: "path('', views.home)",
+			want:    "urlpatterns = [\n    path('', views.home),\n]\n",
+		},
+		{
+			name:    "i18n block",
+			urls:    "urlpatterns = [\n    path('i18n/', include('django.conf.urls.i18n')),\n]\n\nurlpatterns += i18n_patterns(\n    path('admin/', admin.site.urls),\n)\n",
+			pattern: "path('', views.home)",
+			want:    "urlpatterns = [\n    path('i18n/', include('django.conf.urls.i18n')),\n]\n\nurlpatterns += i18n_patterns(\n    path('admin/', admin.site.urls),\n    path('', views.home),\n)\n",
+		},
+		{
+			name:    "already present",
+			urls:    "urlpatterns = [\n    path('', views.home),\n]\n",
+			pattern: "path('', views.home)",
+			want:    "urlpatterns = [\n    path('', views.home),\n]\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addURLPattern(tt.urls, tt.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+			again, err := addURLPattern(got, tt.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again != got {
+				t.Errorf("adding the pattern twice changed the file:\n%s", again)
+			}
+		})
+	}
+}
+
+func TestAddURLPatternWithoutURLPatterns(t *testing.T) {
+	if _, err := addURLPattern("from django.urls import path\n", "path('', views.home)"); err == nil {
+		t.Error("expected an error for a urls.py without urlpatterns")
+	}
+}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"fmt"
-	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
@@ -87,6 +86,47 @@ func addToListInSettingsPy(settingsContent, listName, itemToAdd string) (string,
 	return settingsContent[:listEndIndex] + newEntry + settingsContent[listEndIndex:], nil
 }
 
+// insertIntoListInSettingsPy adds itemToAdd to a settings list directly in
+// front of beforeItem, for entries whose position matters (middleware).
+// It appends to the end of the list when beforeItem is not present.
+func insertIntoListInSettingsPy(settingsContent, listName, itemToAdd, beforeItem string) (string, error) {
+	quotedItem := fmt.Sprintf("'%s'", strings.Trim(itemToAdd, "'\""))
+	if strings.Contains(settingsContent, quotedItem) {
+		return settingsContent, nil
+	}
+
+	listStartIndex := strings.Index(settingsContent, fmt.Sprintf("%s = [", listName))
+	if listStartIndex == -1 {
+		listStartIndex = strings.Index(settingsContent, fmt.Sprintf("%s=[", listName))
+		if listStartIndex == -1 {
+			return settingsContent, fmt.Errorf("could not find list '%s' in settings", listName)
+		}
+	}
+	listEndIndex := strings.Index(settingsContent[listStartIndex:], "]")
+	if listEndIndex == -1 {
+		return settingsContent, fmt.Errorf("could not find closing bracket for list '%s'", listName)
+	}
+	listEndIndex += listStartIndex
+
+	quotedBefore := fmt.Sprintf("'%s'", strings.Trim(beforeItem, "'\""))
+	beforeIndex := strings.Index(settingsContent[listStartIndex:listEndIndex], quotedBefore)
+	if beforeIndex == -1 {
+		return addToListInSettingsPy(settingsContent, listName, itemToAdd)
+	}
+	beforeIndex += listStartIndex
+
+	lineStart := strings.LastIndex(settingsContent[:beforeIndex], "\n") + 1
+	indent := settingsContent[lineStart:beforeIndex]
+	if strings.TrimSpace(indent) != "" {
+		// The item shares a line with others; fall back to a plain append.
+		return addToListInSettingsPy(settingsContent, listName, itemToAdd)
+	}
+
+	return settingsContent[:lineStart] + indent + quotedItem + ",\n" + settingsContent[lineStart:], nil
+}
+
+// validateProjectName checks the project directory name. The Python package
+// name is validated separately since it may differ (see derivePackageName).
 func validateProjectName(name string) error {
 	if name == "" {
 		return fmt.Errorf("project name cannot be empty")
@@ -99,21 +139,6 @@ func validateProjectName(name string) error {
 		}
 	}
 
-	if _, err := os.Stat(name); err == nil {
-		return fmt.Errorf("directory '%s' already exists", name)
-	}
-
-	reserved := []string{"and", "as", "assert", "break", "class", "continue",
-		"def", "del", "elif", "else", "except", "exec", "finally", "for",
-		"from", "global", "if", "import", "in", "is", "lambda", "not",
-		"or", "pass", "print", "raise", "return", "try", "while", "with", "yield"}
-
-	for _, word := range reserved {
-		if strings.ToLower(name) == word {
-			return fmt.Errorf("'%s' is a Python reserved word and cannot be used as project name", name)
-		}
-	}
-
 	return nil
 }
 